@@ -0,0 +1,376 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"time"
+)
+
+var _ resource.Resource = &AMQPPublishResource{}
+var _ resource.ResourceWithConfigure = &AMQPPublishResource{}
+
+type AMQPPublishResource struct {
+	AMQPConfigOptions AMQPConfigOptions
+	AWSClient         *AWSClient
+}
+
+type AMQPPublishResourceModel struct {
+	ContentType      types.String                 `tfsdk:"content_type"`
+	CorrelationId    types.String                 `tfsdk:"correlation_id"`
+	CreateOnly       types.Bool                   `tfsdk:"create_only"`
+	EventId          types.String                 `tfsdk:"event_id"`
+	Exchange         types.String                 `tfsdk:"exchange"`
+	Headers          types.Map                    `tfsdk:"headers"`
+	KMSSignature     []KMSSignatureAttributeModel `tfsdk:"kms_signature"`
+	Mandatory        types.Bool                   `tfsdk:"mandatory"`
+	MD5OfMessageBody types.String                 `tfsdk:"md5_of_message_body"`
+	MessageBody      types.String                 `tfsdk:"message_body"`
+	MessageId        types.String                 `tfsdk:"message_id"`
+	Persistent       types.Bool                   `tfsdk:"persistent"`
+	RoutingKey       types.String                 `tfsdk:"routing_key"`
+	SendCount        types.Int64                  `tfsdk:"send_count"`
+}
+
+func newAMQPPublishResource() resource.Resource {
+	return &AMQPPublishResource{}
+}
+
+func (r *AMQPPublishResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerMeta := request.ProviderData.(Meta)
+
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("unable to load SDK config", err.Error())
+		return
+	}
+
+	if providerMeta.AWSConfigOptions.Region != "" {
+		cfg.Region = providerMeta.AWSConfigOptions.Region
+	}
+
+	kmsClient := kms.NewFromConfig(cfg)
+
+	r.AWSClient = &AWSClient{
+		KMSClient: kmsClient,
+		Region:    providerMeta.AWSConfigOptions.Region,
+	}
+	r.AMQPConfigOptions = providerMeta.AMQPConfigOptions
+}
+
+func (r *AMQPPublishResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_amqp_publish"
+}
+
+func (r *AMQPPublishResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Publish a message to an AMQP 0-9-1 exchange, such as RabbitMQ.",
+		Attributes: map[string]schema.Attribute{
+			"content_type": schema.StringAttribute{
+				Description: "The MIME content type of the message.",
+				Optional:    true,
+			},
+			"correlation_id": schema.StringAttribute{
+				Description: "The correlation ID of the message.",
+				Optional:    true,
+			},
+			"create_only": schema.BoolAttribute{
+				Description: "When enabled, forces resource to be replaced on update.",
+				Optional:    true,
+			},
+			"event_id": schema.StringAttribute{
+				Description: "Generated ID for resource tracking.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"exchange": schema.StringAttribute{
+				Description: "The name of the exchange. Defaults to the default exchange.",
+				Optional:    true,
+			},
+			"headers": schema.MapAttribute{
+				Description: "Headers to send with the message.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"mandatory": schema.BoolAttribute{
+				Description: "When enabled, the publish fails if the message cannot be routed to a queue.",
+				Optional:    true,
+			},
+			"md5_of_message_body": schema.StringAttribute{
+				Description: "The MD5 of the message body.",
+				Computed:    true,
+			},
+			"message_body": schema.StringAttribute{
+				Description: "The message to send.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(replaceIfCreateOnlySet, "Forces replacement of resource.", "Forces replacement of resource."),
+				},
+			},
+			"message_id": schema.StringAttribute{
+				Description: "The message ID. Defaults to a value derived from the event ID, the lifecycle event, the send count and the message body.",
+				Optional:    true,
+			},
+			"persistent": schema.BoolAttribute{
+				Description: "When enabled, the message is published with the persistent delivery mode.",
+				Optional:    true,
+			},
+			"routing_key": schema.StringAttribute{
+				Description: "The routing key of the message.",
+				Required:    true,
+			},
+			"send_count": schema.Int64Attribute{
+				Description: "The number of messages published. Used in the default message ID so each publish has a new ID.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"kms_signature": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"kms_key_id": schema.StringAttribute{
+							Description: "The ID of the AWS KMS key.",
+							Required:    true,
+						},
+						"message_attribute": schema.StringAttribute{
+							Description: "Header name to add signature value.",
+							Optional:    true,
+						},
+						"algorithm": schema.StringAttribute{
+							Description: "The KMS signature algorithm.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOfCaseInsensitive(
+									[]string{
+										string(kmstypes.SigningAlgorithmSpecRsassaPkcs1V15Sha256),
+									}...,
+								),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *AMQPPublishResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data AMQPPublishResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Event ID is set before publishing since it is used for the default message ID
+	data.EventId = types.StringValue(uuid.New().String())
+	data.SendCount = types.Int64Value(0)
+
+	err := publishAMQPMessage(ctx, r, &data, "create")
+	if err != nil {
+		response.Diagnostics.AddError("Error publishing message to AMQP exchange.", err.Error())
+		return
+	}
+
+	data.MD5OfMessageBody = types.StringValue(createMD5OfMessageBody(data.MessageBody.ValueString()))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *AMQPPublishResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data AMQPPublishResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *AMQPPublishResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state AMQPPublishResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	planMessageBodyMD5 := createMD5OfMessageBody(plan.MessageBody.ValueString())
+	stateMessageBodyMD5 := createMD5OfMessageBody(state.MessageBody.ValueString())
+
+	plan.SendCount = state.SendCount
+	if planMessageBodyMD5 != stateMessageBodyMD5 {
+		err := publishAMQPMessage(ctx, r, &plan, "update")
+		if err != nil {
+			response.Diagnostics.AddError("Error publishing message to AMQP exchange.", err.Error())
+			return
+		}
+	}
+	plan.MD5OfMessageBody = types.StringValue(planMessageBodyMD5)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *AMQPPublishResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data AMQPPublishResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.CreateOnly.ValueBool() {
+		err := publishAMQPMessage(ctx, r, &data, "delete")
+		if err != nil {
+			response.Diagnostics.AddError("Error publishing message to AMQP exchange.", err.Error())
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func publishAMQPMessage(ctx context.Context, r *AMQPPublishResource, data *AMQPPublishResourceModel, lifeCycle string) error {
+	headers := amqp.Table{}
+	publishing := amqp.Publishing{
+		Body:         []byte(data.MessageBody.ValueString()),
+		DeliveryMode: amqp.Transient,
+		Timestamp:    time.Now(),
+	}
+
+	if data.Persistent.ValueBool() {
+		publishing.DeliveryMode = amqp.Persistent
+	}
+
+	if !data.ContentType.IsNull() {
+		publishing.ContentType = data.ContentType.ValueString()
+	}
+
+	if !data.CorrelationId.IsNull() {
+		publishing.CorrelationId = data.CorrelationId.ValueString()
+	}
+
+	sendCount := data.SendCount.ValueInt64() + 1
+
+	if !data.MessageId.IsNull() {
+		publishing.MessageId = data.MessageId.ValueString()
+	} else {
+		publishing.MessageId = lifecycleSendId(data.EventId.ValueString(), lifeCycle, sendCount, data.MessageBody.ValueString())
+	}
+
+	if !data.Headers.IsNull() {
+		values := make(map[string]string)
+		diags := data.Headers.ElementsAs(ctx, &values, false)
+		if diags.HasError() {
+			return fmt.Errorf("unable to read headers")
+		}
+
+		for key, value := range values {
+			headers[key] = value
+		}
+	}
+
+	if data.KMSSignature != nil {
+		attrName, signature, err := signMessageWithKMSSignatureBlock(ctx, r.AWSClient.KMSClient, data.KMSSignature[0], data.MessageBody.ValueString())
+		if err != nil {
+			return err
+		}
+		headers[attrName] = signature
+	}
+
+	headers["X-LifeCycle-Event"] = lifeCycle
+	publishing.Headers = headers
+
+	connection, err := newAMQPConnection(r.AMQPConfigOptions)
+	if err != nil {
+		return err
+	}
+	defer connection.Close()
+
+	channel, err := connection.Channel()
+	if err != nil {
+		return fmt.Errorf("failed to open channel: %w", err)
+	}
+	defer channel.Close()
+
+	err = channel.Confirm(false)
+	if err != nil {
+		return fmt.Errorf("failed to enable publisher confirms: %w", err)
+	}
+
+	// The broker sends an unroutable mandatory message back before it confirms it
+	returns := channel.NotifyReturn(make(chan amqp.Return, 1))
+
+	confirmation, err := channel.PublishWithDeferredConfirmWithContext(ctx, data.Exchange.ValueString(), data.RoutingKey.ValueString(), data.Mandatory.ValueBool(), false, publishing)
+	if err != nil {
+		return err
+	}
+
+	acked, err := confirmation.WaitContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !acked {
+		return fmt.Errorf("message was rejected by the broker")
+	}
+
+	select {
+	case returned := <-returns:
+		return fmt.Errorf("message was returned by the broker: %d %s", returned.ReplyCode, returned.ReplyText)
+	default:
+	}
+
+	data.SendCount = types.Int64Value(sendCount)
+
+	return nil
+}
+
+func newAMQPConnection(options AMQPConfigOptions) (*amqp.Connection, error) {
+	if options.URL == "" {
+		return nil, fmt.Errorf("url must be set in the amqp provider block")
+	}
+
+	amqpConfig := amqp.Config{
+		Heartbeat: 10 * time.Second,
+		Locale:    "en_US",
+		Vhost:     options.Vhost,
+	}
+
+	if options.TLSCACert != "" || options.TLSClientCert != "" || options.TLSInsecureSkipVerify {
+		tlsConfig, err := newTLSConfig(options.TLSCACert, options.TLSClientCert, options.TLSClientKey, options.TLSInsecureSkipVerify)
+		if err != nil {
+			return nil, err
+		}
+		amqpConfig.TLSClientConfig = tlsConfig
+	}
+
+	return amqp.DialConfig(options.URL, amqpConfig)
+}
@@ -0,0 +1,297 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	cloudwatchlogstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"time"
+)
+
+var _ resource.Resource = &AWSCloudWatchLogsEventResource{}
+var _ resource.ResourceWithConfigure = &AWSCloudWatchLogsEventResource{}
+
+// Sequence tokens are ignored by current PutLogEvents, but older streams may still reject a stale token
+const cloudWatchLogsMaxSequenceTokenAttempts = 5
+
+type AWSCloudWatchLogsEventResource struct {
+	AWSClient *AWSClient
+}
+
+type AWSCloudWatchLogsEventResourceModel struct {
+	CreateOnly       types.Bool                   `tfsdk:"create_only"`
+	EventId          types.String                 `tfsdk:"event_id"`
+	KMSSignature     []KMSSignatureAttributeModel `tfsdk:"kms_signature"`
+	LogGroupName     types.String                 `tfsdk:"log_group_name"`
+	LogStreamName    types.String                 `tfsdk:"log_stream_name"`
+	MD5OfMessageBody types.String                 `tfsdk:"md5_of_message_body"`
+	MessageBody      types.String                 `tfsdk:"message_body"`
+}
+
+func newAWSCloudWatchLogsEventResource() resource.Resource {
+	return &AWSCloudWatchLogsEventResource{}
+}
+
+func (r *AWSCloudWatchLogsEventResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerMeta := request.ProviderData.(Meta)
+
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("unable to load SDK config", err.Error())
+		return
+	}
+
+	if providerMeta.AWSConfigOptions.Region != "" {
+		cfg.Region = providerMeta.AWSConfigOptions.Region
+	}
+
+	// PutLogEvents is throttled per log stream, so allow more retries than the SDK default
+	cloudWatchLogsClient := cloudwatchlogs.NewFromConfig(cfg, func(o *cloudwatchlogs.Options) {
+		o.Retryer = retry.AddWithMaxAttempts(o.Retryer, 10)
+	})
+	kmsClient := kms.NewFromConfig(cfg)
+
+	r.AWSClient = &AWSClient{
+		CloudWatchLogsClient: cloudWatchLogsClient,
+		KMSClient:            kmsClient,
+		Region:               providerMeta.AWSConfigOptions.Region,
+	}
+}
+
+func (r *AWSCloudWatchLogsEventResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_aws_cloudwatch_logs_event"
+}
+
+func (r *AWSCloudWatchLogsEventResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Write a structured log event to an AWS CloudWatch Logs log stream.",
+		Attributes: map[string]schema.Attribute{
+			"create_only": schema.BoolAttribute{
+				Description: "When enabled, forces resource to be replaced on update.",
+				Optional:    true,
+			},
+			"event_id": schema.StringAttribute{
+				Description: "Generated ID for resource tracking.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"log_group_name": schema.StringAttribute{
+				Description: "The name of the log group.",
+				Required:    true,
+			},
+			"log_stream_name": schema.StringAttribute{
+				Description: "The name of the log stream. The log stream is created if it does not exist.",
+				Required:    true,
+			},
+			"md5_of_message_body": schema.StringAttribute{
+				Description: "The MD5 of the message body.",
+				Computed:    true,
+			},
+			"message_body": schema.StringAttribute{
+				Description: "The message to send.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(replaceIfCreateOnlySet, "Forces replacement of resource.", "Forces replacement of resource."),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"kms_signature": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"kms_key_id": schema.StringAttribute{
+							Description: "The ID of the AWS KMS key.",
+							Required:    true,
+						},
+						"message_attribute": schema.StringAttribute{
+							Description: "Log event field name to add signature value.",
+							Optional:    true,
+						},
+						"algorithm": schema.StringAttribute{
+							Description: "The KMS signature algorithm.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOfCaseInsensitive(
+									[]string{
+										string(kmstypes.SigningAlgorithmSpecRsassaPkcs1V15Sha256),
+									}...,
+								),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *AWSCloudWatchLogsEventResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data AWSCloudWatchLogsEventResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Event ID is set before writing since it is part of the log event
+	data.EventId = types.StringValue(uuid.New().String())
+
+	err := putLogEvent(ctx, r.AWSClient, &data, "create")
+	if err != nil {
+		response.Diagnostics.AddError("Error writing event to CloudWatch Logs.", err.Error())
+		return
+	}
+
+	data.MD5OfMessageBody = types.StringValue(createMD5OfMessageBody(data.MessageBody.ValueString()))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *AWSCloudWatchLogsEventResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data AWSCloudWatchLogsEventResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *AWSCloudWatchLogsEventResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state AWSCloudWatchLogsEventResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	planMessageBodyMD5 := createMD5OfMessageBody(plan.MessageBody.ValueString())
+	stateMessageBodyMD5 := createMD5OfMessageBody(state.MessageBody.ValueString())
+
+	if planMessageBodyMD5 != stateMessageBodyMD5 {
+		err := putLogEvent(ctx, r.AWSClient, &plan, "update")
+		if err != nil {
+			response.Diagnostics.AddError("Error writing event to CloudWatch Logs.", err.Error())
+			return
+		}
+	}
+	plan.MD5OfMessageBody = types.StringValue(planMessageBodyMD5)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *AWSCloudWatchLogsEventResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data AWSCloudWatchLogsEventResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.CreateOnly.ValueBool() {
+		err := putLogEvent(ctx, r.AWSClient, &data, "delete")
+		if err != nil {
+			response.Diagnostics.AddError("Error writing event to CloudWatch Logs.", err.Error())
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func putLogEvent(ctx context.Context, meta *AWSClient, data *AWSCloudWatchLogsEventResourceModel, lifeCycle string) error {
+	logEvent, err := createMessageEnvelope(ctx, meta.KMSClient, data.KMSSignature, data.MessageBody.ValueString(), lifeCycle)
+	if err != nil {
+		return err
+	}
+	logEvent["event_id"] = data.EventId.ValueString()
+
+	message, err := json.Marshal(logEvent)
+	if err != nil {
+		return err
+	}
+
+	_, err = meta.CloudWatchLogsClient.CreateLogStream(ctx, &cloudwatchlogs.CreateLogStreamInput{
+		LogGroupName:  aws.String(data.LogGroupName.ValueString()),
+		LogStreamName: aws.String(data.LogStreamName.ValueString()),
+	})
+	if err != nil {
+		var alreadyExists *cloudwatchlogstypes.ResourceAlreadyExistsException
+		if !errors.As(err, &alreadyExists) {
+			return fmt.Errorf("failed to create log stream: %w", err)
+		}
+	}
+
+	input := &cloudwatchlogs.PutLogEventsInput{
+		LogGroupName:  aws.String(data.LogGroupName.ValueString()),
+		LogStreamName: aws.String(data.LogStreamName.ValueString()),
+		LogEvents: []cloudwatchlogstypes.InputLogEvent{
+			{
+				Message:   aws.String(string(message)),
+				Timestamp: aws.Int64(time.Now().UnixMilli()),
+			},
+		},
+	}
+
+	for attempt := 1; ; attempt++ {
+		output, err := meta.CloudWatchLogsClient.PutLogEvents(ctx, input)
+		if err != nil {
+			var invalidSequenceToken *cloudwatchlogstypes.InvalidSequenceTokenException
+			if errors.As(err, &invalidSequenceToken) && attempt < cloudWatchLogsMaxSequenceTokenAttempts {
+				input.SequenceToken = invalidSequenceToken.ExpectedSequenceToken
+				continue
+			}
+
+			var dataAlreadyAccepted *cloudwatchlogstypes.DataAlreadyAcceptedException
+			if errors.As(err, &dataAlreadyAccepted) {
+				return nil
+			}
+
+			return err
+		}
+
+		if info := output.RejectedLogEventsInfo; info != nil {
+			switch {
+			case info.TooOldLogEventEndIndex != nil:
+				return fmt.Errorf("log event was rejected for being too old")
+			case info.TooNewLogEventStartIndex != nil:
+				return fmt.Errorf("log event was rejected for being too new")
+			case info.ExpiredLogEventEndIndex != nil:
+				return fmt.Errorf("log event was rejected for being older than the log group retention")
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"time"
+)
+
+var _ resource.Resource = &AWSCloudWatchMetricMarkerResource{}
+var _ resource.ResourceWithConfigure = &AWSCloudWatchMetricMarkerResource{}
+
+type AWSCloudWatchMetricMarkerResource struct {
+	AWSClient *AWSClient
+}
+
+type AWSCloudWatchMetricMarkerResourceModel struct {
+	CreateOnly         types.Bool    `tfsdk:"create_only"`
+	CreateValue        types.Float64 `tfsdk:"create_value"`
+	DeleteValue        types.Float64 `tfsdk:"delete_value"`
+	Dimensions         types.Map     `tfsdk:"dimensions"`
+	EventId            types.String  `tfsdk:"event_id"`
+	LifeCycleDimension types.String  `tfsdk:"lifecycle_dimension"`
+	MetricName         types.String  `tfsdk:"metric_name"`
+	Namespace          types.String  `tfsdk:"namespace"`
+	Unit               types.String  `tfsdk:"unit"`
+	UpdateValue        types.Float64 `tfsdk:"update_value"`
+}
+
+func newAWSCloudWatchMetricMarkerResource() resource.Resource {
+	return &AWSCloudWatchMetricMarkerResource{}
+}
+
+func (r *AWSCloudWatchMetricMarkerResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerMeta := request.ProviderData.(Meta)
+
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("unable to load SDK config", err.Error())
+		return
+	}
+
+	if providerMeta.AWSConfigOptions.Region != "" {
+		cfg.Region = providerMeta.AWSConfigOptions.Region
+	}
+
+	cloudWatchClient := cloudwatch.NewFromConfig(cfg)
+
+	r.AWSClient = &AWSClient{
+		CloudWatchClient: cloudWatchClient,
+		Region:           providerMeta.AWSConfigOptions.Region,
+	}
+}
+
+func (r *AWSCloudWatchMetricMarkerResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_aws_cloudwatch_metric_marker"
+}
+
+func (r *AWSCloudWatchMetricMarkerResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	units := make([]string, 0)
+	for _, unit := range cloudwatchtypes.StandardUnit("").Values() {
+		units = append(units, string(unit))
+	}
+
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Put a deployment marker data point into an AWS CloudWatch metric.",
+		Attributes: map[string]schema.Attribute{
+			"create_only": schema.BoolAttribute{
+				Description: "When enabled, forces resource to be replaced on update.",
+				Optional:    true,
+			},
+			"create_value": schema.Float64Attribute{
+				Description: "The value put on create. Defaults to 1.",
+				Optional:    true,
+			},
+			"delete_value": schema.Float64Attribute{
+				Description: "The value put on delete. Defaults to 0.",
+				Optional:    true,
+			},
+			"dimensions": schema.MapAttribute{
+				Description: "Dimensions to associate with the metric, in addition to the lifecycle event dimension.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"event_id": schema.StringAttribute{
+				Description: "Generated ID for resource tracking.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"lifecycle_dimension": schema.StringAttribute{
+				Description: "The name of the dimension holding the lifecycle event. Defaults to `X-LifeCycle-Event`.",
+				Optional:    true,
+			},
+			"metric_name": schema.StringAttribute{
+				Description: "The name of the metric.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(replaceIfCreateOnlySet, "Forces replacement of resource.", "Forces replacement of resource."),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				Description: "The namespace for the metric data.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(replaceIfCreateOnlySet, "Forces replacement of resource.", "Forces replacement of resource."),
+				},
+			},
+			"unit": schema.StringAttribute{
+				Description: "The unit of the metric.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(units...),
+				},
+			},
+			"update_value": schema.Float64Attribute{
+				Description: "The value put on update. Defaults to 1.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *AWSCloudWatchMetricMarkerResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data AWSCloudWatchMetricMarkerResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	err := putMetricMarker(ctx, r.AWSClient, &data, "create")
+	if err != nil {
+		response.Diagnostics.AddError("Error putting CloudWatch metric data.", err.Error())
+		return
+	}
+
+	data.EventId = types.StringValue(uuid.New().String())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *AWSCloudWatchMetricMarkerResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data AWSCloudWatchMetricMarkerResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *AWSCloudWatchMetricMarkerResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan AWSCloudWatchMetricMarkerResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// There is no message body to compare, so every update puts a marker
+	err := putMetricMarker(ctx, r.AWSClient, &plan, "update")
+	if err != nil {
+		response.Diagnostics.AddError("Error putting CloudWatch metric data.", err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *AWSCloudWatchMetricMarkerResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data AWSCloudWatchMetricMarkerResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.CreateOnly.ValueBool() {
+		err := putMetricMarker(ctx, r.AWSClient, &data, "delete")
+		if err != nil {
+			response.Diagnostics.AddError("Error putting CloudWatch metric data.", err.Error())
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func putMetricMarker(ctx context.Context, meta *AWSClient, data *AWSCloudWatchMetricMarkerResourceModel, lifeCycle string) error {
+	var value float64
+	switch lifeCycle {
+	case "create":
+		value = 1
+		if !data.CreateValue.IsNull() {
+			value = data.CreateValue.ValueFloat64()
+		}
+	case "update":
+		value = 1
+		if !data.UpdateValue.IsNull() {
+			value = data.UpdateValue.ValueFloat64()
+		}
+	case "delete":
+		value = 0
+		if !data.DeleteValue.IsNull() {
+			value = data.DeleteValue.ValueFloat64()
+		}
+	}
+
+	dimensions := make(map[string]string)
+	if !data.Dimensions.IsNull() {
+		diags := data.Dimensions.ElementsAs(ctx, &dimensions, false)
+		if diags.HasError() {
+			return fmt.Errorf("unable to read dimensions")
+		}
+	}
+
+	lifeCycleDimension := "X-LifeCycle-Event"
+	if !data.LifeCycleDimension.IsNull() {
+		lifeCycleDimension = data.LifeCycleDimension.ValueString()
+	}
+	dimensions[lifeCycleDimension] = lifeCycle
+
+	metricDatum := cloudwatchtypes.MetricDatum{
+		MetricName: aws.String(data.MetricName.ValueString()),
+		Timestamp:  aws.Time(time.Now()),
+		Value:      aws.Float64(value),
+	}
+
+	for name, dimensionValue := range dimensions {
+		metricDatum.Dimensions = append(metricDatum.Dimensions, cloudwatchtypes.Dimension{
+			Name:  aws.String(name),
+			Value: aws.String(dimensionValue),
+		})
+	}
+
+	if !data.Unit.IsNull() {
+		metricDatum.Unit = cloudwatchtypes.StandardUnit(data.Unit.ValueString())
+	}
+
+	_, err := meta.CloudWatchClient.PutMetricData(ctx, &cloudwatch.PutMetricDataInput{
+		Namespace:  aws.String(data.Namespace.ValueString()),
+		MetricData: []cloudwatchtypes.MetricDatum{metricDatum},
+	})
+	return err
+}
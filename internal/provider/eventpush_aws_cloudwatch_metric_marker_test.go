@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccEventPushCloudWatchMetricMarker_Simple(t *testing.T) {
+	config1 := `
+resource "eventpush_aws_cloudwatch_metric_marker" "test" {
+  namespace   = "EventPush/Test"
+  metric_name = "Deployment"
+
+  dimensions = {
+    Stack = "test-1"
+  }
+}
+`
+
+	config2 := `
+resource "eventpush_aws_cloudwatch_metric_marker" "test" {
+  namespace   = "EventPush/Test"
+  metric_name = "Deployment"
+
+  dimensions = {
+    Stack = "test-2"
+  }
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_aws_cloudwatch_metric_marker.test", "namespace", "EventPush/Test"),
+				),
+			},
+			{
+				RefreshState: true,
+			},
+			{
+				Config: config2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_aws_cloudwatch_metric_marker.test", "dimensions.Stack", "test-2"),
+				),
+			},
+			{
+				Config:  config2,
+				Destroy: true,
+			},
+		},
+	})
+}
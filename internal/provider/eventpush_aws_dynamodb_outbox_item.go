@@ -0,0 +1,332 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	dynamodbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+	"time"
+)
+
+var _ resource.Resource = &AWSDynamoDBOutboxItemResource{}
+var _ resource.ResourceWithConfigure = &AWSDynamoDBOutboxItemResource{}
+
+type AWSDynamoDBOutboxItemResource struct {
+	AWSClient *AWSClient
+}
+
+type AWSDynamoDBOutboxItemResourceModel struct {
+	CreateOnly       types.Bool                   `tfsdk:"create_only"`
+	EventId          types.String                 `tfsdk:"event_id"`
+	KMSSignature     []KMSSignatureAttributeModel `tfsdk:"kms_signature"`
+	MD5OfMessageBody types.String                 `tfsdk:"md5_of_message_body"`
+	MessageBody      types.String                 `tfsdk:"message_body"`
+	PartitionKeyName types.String                 `tfsdk:"partition_key_name"`
+	SendCount        types.Int64                  `tfsdk:"send_count"`
+	SortKeyName      types.String                 `tfsdk:"sort_key_name"`
+	StaticAttributes types.Map                    `tfsdk:"static_attributes"`
+	TableName        types.String                 `tfsdk:"table_name"`
+	TTLAttributeName types.String                 `tfsdk:"ttl_attribute_name"`
+	TTLSeconds       types.Int64                  `tfsdk:"ttl_seconds"`
+}
+
+func newAWSDynamoDBOutboxItemResource() resource.Resource {
+	return &AWSDynamoDBOutboxItemResource{}
+}
+
+func (r *AWSDynamoDBOutboxItemResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerMeta := request.ProviderData.(Meta)
+
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("unable to load SDK config", err.Error())
+		return
+	}
+
+	if providerMeta.AWSConfigOptions.Region != "" {
+		cfg.Region = providerMeta.AWSConfigOptions.Region
+	}
+
+	dynamoDBClient := dynamodb.NewFromConfig(cfg)
+	kmsClient := kms.NewFromConfig(cfg)
+
+	r.AWSClient = &AWSClient{
+		DynamoDBClient: dynamoDBClient,
+		KMSClient:      kmsClient,
+		Region:         providerMeta.AWSConfigOptions.Region,
+	}
+}
+
+func (r *AWSDynamoDBOutboxItemResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_aws_dynamodb_outbox_item"
+}
+
+func (r *AWSDynamoDBOutboxItemResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Write an event record to an AWS DynamoDB outbox table.",
+		Attributes: map[string]schema.Attribute{
+			"create_only": schema.BoolAttribute{
+				Description: "When enabled, forces resource to be replaced on update.",
+				Optional:    true,
+			},
+			"event_id": schema.StringAttribute{
+				Description: "Generated ID for resource tracking.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"md5_of_message_body": schema.StringAttribute{
+				Description: "The MD5 of the message body.",
+				Computed:    true,
+			},
+			"message_body": schema.StringAttribute{
+				Description: "The message to send.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(replaceIfCreateOnlySet, "Forces replacement of resource.", "Forces replacement of resource."),
+				},
+			},
+			"partition_key_name": schema.StringAttribute{
+				Description: "The name of the table partition key attribute. Defaults to `pk`.",
+				Optional:    true,
+			},
+			"send_count": schema.Int64Attribute{
+				Description: "The number of update items written. Each update item is keyed `update-N`, where N is the send count including that update, so a retried apply writes the same item while every change gets a new one.",
+				Computed:    true,
+			},
+			"sort_key_name": schema.StringAttribute{
+				Description: "The name of the table sort key attribute. When set, the partition key holds the event ID and the sort key holds `create`, `update-N` or `delete`, otherwise the partition key holds both joined by `#`.",
+				Optional:    true,
+			},
+			"static_attributes": schema.MapAttribute{
+				Description: "Additional string attributes to write with every item.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"table_name": schema.StringAttribute{
+				Description: "The name of the outbox table.",
+				Required:    true,
+			},
+			"ttl_attribute_name": schema.StringAttribute{
+				Description: "The name of the table TTL attribute. Defaults to `ttl`.",
+				Optional:    true,
+			},
+			"ttl_seconds": schema.Int64Attribute{
+				Description: "When set, items expire this many seconds after they are written.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"kms_signature": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"kms_key_id": schema.StringAttribute{
+							Description: "The ID of the AWS KMS key.",
+							Required:    true,
+						},
+						"message_attribute": schema.StringAttribute{
+							Description: "Item attribute name to add signature value.",
+							Optional:    true,
+						},
+						"algorithm": schema.StringAttribute{
+							Description: "The KMS signature algorithm.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOfCaseInsensitive(
+									[]string{
+										string(kmstypes.SigningAlgorithmSpecRsassaPkcs1V15Sha256),
+									}...,
+								),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *AWSDynamoDBOutboxItemResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data AWSDynamoDBOutboxItemResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Event ID is set before writing since it is part of the item key
+	data.EventId = types.StringValue(uuid.New().String())
+	data.SendCount = types.Int64Value(0)
+
+	err := putOutboxItem(ctx, r.AWSClient, &data, "create", "create")
+	if err != nil {
+		response.Diagnostics.AddError("Error writing item to DynamoDB table.", err.Error())
+		return
+	}
+
+	data.MD5OfMessageBody = types.StringValue(createMD5OfMessageBody(data.MessageBody.ValueString()))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *AWSDynamoDBOutboxItemResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data AWSDynamoDBOutboxItemResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *AWSDynamoDBOutboxItemResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state AWSDynamoDBOutboxItemResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	planMessageBodyMD5 := createMD5OfMessageBody(plan.MessageBody.ValueString())
+	stateMessageBodyMD5 := createMD5OfMessageBody(state.MessageBody.ValueString())
+
+	plan.SendCount = state.SendCount
+	if planMessageBodyMD5 != stateMessageBodyMD5 {
+		// Each update is keyed by its count, so a retried apply writes to the same key
+		sendCount := state.SendCount.ValueInt64() + 1
+
+		err := putOutboxItem(ctx, r.AWSClient, &plan, "update", fmt.Sprintf("update-%d", sendCount))
+		if err != nil {
+			response.Diagnostics.AddError("Error writing item to DynamoDB table.", err.Error())
+			return
+		}
+		plan.SendCount = types.Int64Value(sendCount)
+	}
+	plan.MD5OfMessageBody = types.StringValue(planMessageBodyMD5)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *AWSDynamoDBOutboxItemResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data AWSDynamoDBOutboxItemResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.CreateOnly.ValueBool() {
+		err := putOutboxItem(ctx, r.AWSClient, &data, "delete", "delete")
+		if err != nil {
+			response.Diagnostics.AddError("Error writing item to DynamoDB table.", err.Error())
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func putOutboxItem(ctx context.Context, meta *AWSClient, data *AWSDynamoDBOutboxItemResourceModel, lifeCycle, lifeCycleKey string) error {
+	now := time.Now().UTC()
+	item := make(map[string]dynamodbtypes.AttributeValue)
+
+	if !data.StaticAttributes.IsNull() {
+		attributes := make(map[string]string)
+		diags := data.StaticAttributes.ElementsAs(ctx, &attributes, false)
+		if diags.HasError() {
+			return fmt.Errorf("unable to read static attributes")
+		}
+
+		for name, value := range attributes {
+			item[name] = &dynamodbtypes.AttributeValueMemberS{Value: value}
+		}
+	}
+
+	item["event_id"] = &dynamodbtypes.AttributeValueMemberS{Value: data.EventId.ValueString()}
+	item["lifecycle"] = &dynamodbtypes.AttributeValueMemberS{Value: lifeCycle}
+	item["message_body"] = &dynamodbtypes.AttributeValueMemberS{Value: data.MessageBody.ValueString()}
+	item["timestamp"] = &dynamodbtypes.AttributeValueMemberS{Value: now.Format(time.RFC3339)}
+
+	if data.KMSSignature != nil {
+		attrName, signature, err := signMessageWithKMSSignatureBlock(ctx, meta.KMSClient, data.KMSSignature[0], data.MessageBody.ValueString())
+		if err != nil {
+			return err
+		}
+		item[attrName] = &dynamodbtypes.AttributeValueMemberS{Value: signature}
+	}
+
+	if !data.TTLSeconds.IsNull() {
+		ttlAttributeName := "ttl"
+		if !data.TTLAttributeName.IsNull() {
+			ttlAttributeName = data.TTLAttributeName.ValueString()
+		}
+
+		expiresAt := now.Add(time.Duration(data.TTLSeconds.ValueInt64()) * time.Second).Unix()
+		item[ttlAttributeName] = &dynamodbtypes.AttributeValueMemberN{Value: strconv.FormatInt(expiresAt, 10)}
+	}
+
+	partitionKeyName := "pk"
+	if !data.PartitionKeyName.IsNull() {
+		partitionKeyName = data.PartitionKeyName.ValueString()
+	}
+
+	// Key attributes are set last so they are never overwritten by other attributes
+	if !data.SortKeyName.IsNull() {
+		item[partitionKeyName] = &dynamodbtypes.AttributeValueMemberS{Value: data.EventId.ValueString()}
+		item[data.SortKeyName.ValueString()] = &dynamodbtypes.AttributeValueMemberS{Value: lifeCycleKey}
+	} else {
+		item[partitionKeyName] = &dynamodbtypes.AttributeValueMemberS{Value: fmt.Sprintf("%s#%s", data.EventId.ValueString(), lifeCycleKey)}
+	}
+
+	_, err := meta.DynamoDBClient.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:           aws.String(data.TableName.ValueString()),
+		Item:                item,
+		ConditionExpression: aws.String("attribute_not_exists(#pk)"),
+		ExpressionAttributeNames: map[string]string{
+			"#pk": partitionKeyName,
+		},
+	})
+	if err != nil {
+		// The item was already written by an earlier attempt of the same apply
+		var conditionalCheckFailed *dynamodbtypes.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailed) {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
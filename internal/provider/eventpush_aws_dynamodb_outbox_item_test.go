@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccEventPushDynamoDBOutboxItem_Simple(t *testing.T) {
+	config1 := `
+resource "eventpush_aws_dynamodb_outbox_item" "test" {
+  table_name    = "eventpush-outbox"
+  message_body  = "test message 1"
+  sort_key_name = "sk"
+  ttl_seconds   = 86400
+
+  static_attributes = {
+    source = "eventpush"
+  }
+}
+`
+
+	config2 := `
+resource "eventpush_aws_dynamodb_outbox_item" "test" {
+  table_name    = "eventpush-outbox"
+  message_body  = "test message 2"
+  sort_key_name = "sk"
+  ttl_seconds   = 86400
+
+  static_attributes = {
+    source = "eventpush"
+  }
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_aws_dynamodb_outbox_item.test", "table_name", "eventpush-outbox"),
+					resource.TestCheckResourceAttr("eventpush_aws_dynamodb_outbox_item.test", "send_count", "0"),
+				),
+			},
+			{
+				RefreshState: true,
+			},
+			{
+				Config: config2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_aws_dynamodb_outbox_item.test", "table_name", "eventpush-outbox"),
+					resource.TestCheckResourceAttr("eventpush_aws_dynamodb_outbox_item.test", "send_count", "1"),
+				),
+			},
+			{
+				Config:  config2,
+				Destroy: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,265 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	eventbridgetypes "github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &AWSEventBridgePutEventsResource{}
+var _ resource.ResourceWithConfigure = &AWSEventBridgePutEventsResource{}
+
+type AWSEventBridgePutEventsResource struct {
+	AWSClient *AWSClient
+}
+
+type AWSEventBridgePutEventsResourceModel struct {
+	CreateOnly         types.Bool   `tfsdk:"create_only"`
+	Detail             types.String `tfsdk:"detail"`
+	DetailType         types.String `tfsdk:"detail_type"`
+	EventBridgeEventId types.String `tfsdk:"eventbridge_event_id"`
+	EventBusName       types.String `tfsdk:"event_bus_name"`
+	EventId            types.String `tfsdk:"event_id"`
+	MD5OfDetail        types.String `tfsdk:"md5_of_detail"`
+	Resources          types.List   `tfsdk:"resources"`
+	Source             types.String `tfsdk:"source"`
+	TraceHeader        types.String `tfsdk:"trace_header"`
+}
+
+func newAWSEventBridgePutEventsResource() resource.Resource {
+	return &AWSEventBridgePutEventsResource{}
+}
+
+func (r *AWSEventBridgePutEventsResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerMeta := request.ProviderData.(Meta)
+
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("unable to load SDK config", err.Error())
+		return
+	}
+
+	if providerMeta.AWSConfigOptions.Region != "" {
+		cfg.Region = providerMeta.AWSConfigOptions.Region
+	}
+
+	eventBridgeClient := eventbridge.NewFromConfig(cfg)
+
+	r.AWSClient = &AWSClient{
+		EventBridgeClient: eventBridgeClient,
+		Region:            providerMeta.AWSConfigOptions.Region,
+	}
+}
+
+func (r *AWSEventBridgePutEventsResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_aws_eventbridge_put_events"
+}
+
+func (r *AWSEventBridgePutEventsResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Put an event on an AWS EventBridge event bus.",
+		Attributes: map[string]schema.Attribute{
+			"create_only": schema.BoolAttribute{
+				Description: "When enabled, forces resource to be replaced on update.",
+				Optional:    true,
+			},
+			"detail": schema.StringAttribute{
+				Description: "A valid JSON object used as the event detail. The lifecycle event is added to the object under the `X-LifeCycle-Event` key.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(replaceIfCreateOnlySet, "Forces replacement of resource.", "Forces replacement of resource."),
+				},
+			},
+			"detail_type": schema.StringAttribute{
+				Description: "Free-form string used to decide what fields to expect in the event detail.",
+				Required:    true,
+			},
+			"eventbridge_event_id": schema.StringAttribute{
+				Description: "The ID of the last event accepted by EventBridge.",
+				Computed:    true,
+			},
+			"event_bus_name": schema.StringAttribute{
+				Description: "The name or ARN of the event bus to receive the event. Uses the default event bus when not set.",
+				Optional:    true,
+			},
+			"event_id": schema.StringAttribute{
+				Description: "Generated ID for resource tracking.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"md5_of_detail": schema.StringAttribute{
+				Description: "The MD5 of the event detail.",
+				Computed:    true,
+			},
+			"resources": schema.ListAttribute{
+				Description: "AWS resources, identified by ARN, which the event primarily concerns.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"source": schema.StringAttribute{
+				Description: "The source of the event.",
+				Required:    true,
+			},
+			"trace_header": schema.StringAttribute{
+				Description: "An AWS X-Ray trace header to associate with the event.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *AWSEventBridgePutEventsResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data AWSEventBridgePutEventsResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	err := putEvent(ctx, r.AWSClient, &data, "create")
+	if err != nil {
+		response.Diagnostics.AddError("Error putting event to EventBridge bus.", err.Error())
+		return
+	}
+
+	data.EventId = types.StringValue(uuid.New().String())
+	data.MD5OfDetail = types.StringValue(createMD5OfMessageBody(data.Detail.ValueString()))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *AWSEventBridgePutEventsResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data AWSEventBridgePutEventsResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *AWSEventBridgePutEventsResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state AWSEventBridgePutEventsResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	planDetailMD5 := createMD5OfMessageBody(plan.Detail.ValueString())
+	stateDetailMD5 := createMD5OfMessageBody(state.Detail.ValueString())
+
+	if planDetailMD5 != stateDetailMD5 {
+		err := putEvent(ctx, r.AWSClient, &plan, "update")
+		if err != nil {
+			response.Diagnostics.AddError("Error putting event to EventBridge bus.", err.Error())
+			return
+		}
+	} else {
+		plan.EventBridgeEventId = state.EventBridgeEventId
+	}
+	plan.MD5OfDetail = types.StringValue(planDetailMD5)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *AWSEventBridgePutEventsResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data AWSEventBridgePutEventsResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.CreateOnly.ValueBool() {
+		err := putEvent(ctx, r.AWSClient, &data, "delete")
+		if err != nil {
+			response.Diagnostics.AddError("Error putting event to EventBridge bus.", err.Error())
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func putEvent(ctx context.Context, meta *AWSClient, data *AWSEventBridgePutEventsResourceModel, lifeCycle string) error {
+	// EventBridge has no message attributes, so the lifecycle event is carried in the detail
+	var detail map[string]any
+	if err := json.Unmarshal([]byte(data.Detail.ValueString()), &detail); err != nil {
+		return fmt.Errorf("detail must be a valid JSON object: %w", err)
+	}
+	if detail == nil {
+		return fmt.Errorf("detail must be a valid JSON object")
+	}
+	detail["X-LifeCycle-Event"] = lifeCycle
+
+	detailJSON, err := json.Marshal(detail)
+	if err != nil {
+		return err
+	}
+
+	entry := eventbridgetypes.PutEventsRequestEntry{
+		Source:     aws.String(data.Source.ValueString()),
+		DetailType: aws.String(data.DetailType.ValueString()),
+		Detail:     aws.String(string(detailJSON)),
+	}
+
+	if !data.EventBusName.IsNull() {
+		entry.EventBusName = aws.String(data.EventBusName.ValueString())
+	}
+
+	if !data.TraceHeader.IsNull() {
+		entry.TraceHeader = aws.String(data.TraceHeader.ValueString())
+	}
+
+	if !data.Resources.IsNull() {
+		diags := data.Resources.ElementsAs(ctx, &entry.Resources, false)
+		if diags.HasError() {
+			return fmt.Errorf("unable to read resources")
+		}
+	}
+
+	output, err := meta.EventBridgeClient.PutEvents(ctx, &eventbridge.PutEventsInput{
+		Entries: []eventbridgetypes.PutEventsRequestEntry{entry},
+	})
+	if err != nil {
+		return err
+	}
+
+	if output.FailedEntryCount > 0 {
+		for _, result := range output.Entries {
+			if result.ErrorCode != nil {
+				return fmt.Errorf("event was rejected: %s: %s", aws.ToString(result.ErrorCode), aws.ToString(result.ErrorMessage))
+			}
+		}
+		return fmt.Errorf("%d event(s) failed to be put", output.FailedEntryCount)
+	}
+
+	if len(output.Entries) > 0 {
+		data.EventBridgeEventId = types.StringPointerValue(output.Entries[0].EventId)
+	}
+
+	return nil
+}
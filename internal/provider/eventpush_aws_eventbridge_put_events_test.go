@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccEventPushEventBridgePutEvents_Simple(t *testing.T) {
+	config1 := `
+resource "eventpush_aws_eventbridge_put_events" "test" {
+  event_bus_name = "TestBus"
+  source         = "eventpush.test"
+  detail_type    = "TestEvent"
+  detail         = jsonencode({ message = "test message 1" })
+}
+`
+
+	config2 := `
+resource "eventpush_aws_eventbridge_put_events" "test" {
+  event_bus_name = "TestBus"
+  source         = "eventpush.test"
+  detail_type    = "TestEvent"
+  detail         = jsonencode({ message = "test message 2" })
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_aws_eventbridge_put_events.test", "event_bus_name", "TestBus"),
+					resource.TestCheckResourceAttrSet("eventpush_aws_eventbridge_put_events.test", "eventbridge_event_id"),
+				),
+			},
+			{
+				RefreshState: true,
+			},
+			{
+				Config: config2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_aws_eventbridge_put_events.test", "event_bus_name", "TestBus"),
+					resource.TestCheckResourceAttrSet("eventpush_aws_eventbridge_put_events.test", "eventbridge_event_id"),
+				),
+			},
+		},
+	})
+}
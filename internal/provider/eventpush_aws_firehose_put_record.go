@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/firehose"
+	firehosetypes "github.com/aws/aws-sdk-go-v2/service/firehose/types"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &AWSFirehosePutRecordResource{}
+var _ resource.ResourceWithConfigure = &AWSFirehosePutRecordResource{}
+var _ resource.ResourceWithValidateConfig = &AWSFirehosePutRecordResource{}
+
+type AWSFirehosePutRecordResource struct {
+	AWSClient *AWSClient
+}
+
+type AWSFirehosePutRecordResourceModel struct {
+	AppendNewline      types.Bool                   `tfsdk:"append_newline"`
+	CreateOnly         types.Bool                   `tfsdk:"create_only"`
+	DeliveryStreamName types.String                 `tfsdk:"delivery_stream_name"`
+	Encrypted          types.Bool                   `tfsdk:"encrypted"`
+	Envelope           types.Bool                   `tfsdk:"envelope"`
+	EventId            types.String                 `tfsdk:"event_id"`
+	KMSSignature       []KMSSignatureAttributeModel `tfsdk:"kms_signature"`
+	MD5OfMessageBody   types.String                 `tfsdk:"md5_of_message_body"`
+	MessageBody        types.String                 `tfsdk:"message_body"`
+	RecordId           types.String                 `tfsdk:"record_id"`
+}
+
+func newAWSFirehosePutRecordResource() resource.Resource {
+	return &AWSFirehosePutRecordResource{}
+}
+
+func (r *AWSFirehosePutRecordResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerMeta := request.ProviderData.(Meta)
+
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("unable to load SDK config", err.Error())
+		return
+	}
+
+	if providerMeta.AWSConfigOptions.Region != "" {
+		cfg.Region = providerMeta.AWSConfigOptions.Region
+	}
+
+	firehoseClient := firehose.NewFromConfig(cfg)
+	kmsClient := kms.NewFromConfig(cfg)
+
+	r.AWSClient = &AWSClient{
+		FirehoseClient: firehoseClient,
+		KMSClient:      kmsClient,
+		Region:         providerMeta.AWSConfigOptions.Region,
+	}
+}
+
+func (r *AWSFirehosePutRecordResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_aws_firehose_put_record"
+}
+
+func (r *AWSFirehosePutRecordResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Put a record into an AWS Kinesis Data Firehose delivery stream.",
+		Attributes: map[string]schema.Attribute{
+			"append_newline": schema.BoolAttribute{
+				Description: "When enabled, a newline delimiter is appended to the record so records can be separated at the destination.",
+				Optional:    true,
+			},
+			"create_only": schema.BoolAttribute{
+				Description: "When enabled, forces resource to be replaced on update.",
+				Optional:    true,
+			},
+			"delivery_stream_name": schema.StringAttribute{
+				Description: "The name of the delivery stream.",
+				Required:    true,
+			},
+			"encrypted": schema.BoolAttribute{
+				Description: "Indicates whether server-side encryption was enabled when the last record was put.",
+				Computed:    true,
+			},
+			"envelope": schema.BoolAttribute{
+				Description: "When enabled, the record is written as a JSON envelope containing the message body, the lifecycle event and the KMS signature. Defaults to true. Disabling it requires `create_only`.",
+				Optional:    true,
+			},
+			"event_id": schema.StringAttribute{
+				Description: "Generated ID for resource tracking.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"md5_of_message_body": schema.StringAttribute{
+				Description: "The MD5 of the message body.",
+				Computed:    true,
+			},
+			"message_body": schema.StringAttribute{
+				Description: "The message to send.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(replaceIfCreateOnlySet, "Forces replacement of resource.", "Forces replacement of resource."),
+				},
+			},
+			"record_id": schema.StringAttribute{
+				Description: "The ID of the last record put.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"kms_signature": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"kms_key_id": schema.StringAttribute{
+							Description: "The ID of the AWS KMS key.",
+							Required:    true,
+						},
+						"message_attribute": schema.StringAttribute{
+							Description: "Envelope field name to add signature value.",
+							Optional:    true,
+						},
+						"algorithm": schema.StringAttribute{
+							Description: "The KMS signature algorithm.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOfCaseInsensitive(
+									[]string{
+										string(kmstypes.SigningAlgorithmSpecRsassaPkcs1V15Sha256),
+									}...,
+								),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *AWSFirehosePutRecordResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data AWSFirehosePutRecordResourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	validateMessageEnvelope(data.Envelope, data.CreateOnly, data.KMSSignature, "Firehose records", &response.Diagnostics)
+}
+
+func (r *AWSFirehosePutRecordResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data AWSFirehosePutRecordResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	err := putFirehoseRecord(ctx, r.AWSClient, &data, "create")
+	if err != nil {
+		response.Diagnostics.AddError("Error putting record to Firehose delivery stream.", err.Error())
+		return
+	}
+
+	data.EventId = types.StringValue(uuid.New().String())
+	data.MD5OfMessageBody = types.StringValue(createMD5OfMessageBody(data.MessageBody.ValueString()))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *AWSFirehosePutRecordResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data AWSFirehosePutRecordResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *AWSFirehosePutRecordResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state AWSFirehosePutRecordResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	planMessageBodyMD5 := createMD5OfMessageBody(plan.MessageBody.ValueString())
+	stateMessageBodyMD5 := createMD5OfMessageBody(state.MessageBody.ValueString())
+
+	if planMessageBodyMD5 != stateMessageBodyMD5 {
+		err := putFirehoseRecord(ctx, r.AWSClient, &plan, "update")
+		if err != nil {
+			response.Diagnostics.AddError("Error putting record to Firehose delivery stream.", err.Error())
+			return
+		}
+	} else {
+		plan.Encrypted = state.Encrypted
+		plan.RecordId = state.RecordId
+	}
+	plan.MD5OfMessageBody = types.StringValue(planMessageBodyMD5)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *AWSFirehosePutRecordResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data AWSFirehosePutRecordResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.CreateOnly.ValueBool() {
+		err := putFirehoseRecord(ctx, r.AWSClient, &data, "delete")
+		if err != nil {
+			response.Diagnostics.AddError("Error putting record to Firehose delivery stream.", err.Error())
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func putFirehoseRecord(ctx context.Context, meta *AWSClient, data *AWSFirehosePutRecordResourceModel, lifeCycle string) error {
+	recordData := []byte(data.MessageBody.ValueString())
+
+	// Firehose records have no attributes, so the lifecycle event and signature are carried in an envelope
+	if messageEnvelopeEnabled(data.Envelope) {
+		envelope, err := createMessageEnvelope(ctx, meta.KMSClient, data.KMSSignature, data.MessageBody.ValueString(), lifeCycle)
+		if err != nil {
+			return err
+		}
+
+		recordData, err = json.Marshal(envelope)
+		if err != nil {
+			return err
+		}
+	}
+
+	if data.AppendNewline.ValueBool() {
+		recordData = append(recordData, '\n')
+	}
+
+	output, err := meta.FirehoseClient.PutRecord(ctx, &firehose.PutRecordInput{
+		DeliveryStreamName: aws.String(data.DeliveryStreamName.ValueString()),
+		Record: &firehosetypes.Record{
+			Data: recordData,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	data.Encrypted = types.BoolPointerValue(output.Encrypted)
+	data.RecordId = types.StringPointerValue(output.RecordId)
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccEventPushFirehosePutRecord_Simple(t *testing.T) {
+	config1 := `
+resource "eventpush_aws_firehose_put_record" "test" {
+  message_body         = "test message 1"
+  delivery_stream_name = "TestDeliveryStream"
+  append_newline       = true
+  envelope             = true
+
+  kms_signature {
+    kms_key_id = "arn:aws:kms:us-east-2:242306084486:key/9834cc70-67b2-446b-b921-34feb2c33406"
+  }
+}
+`
+
+	config2 := `
+resource "eventpush_aws_firehose_put_record" "test" {
+  message_body         = "test message 2"
+  delivery_stream_name = "TestDeliveryStream"
+  append_newline       = true
+  envelope             = true
+
+  kms_signature {
+    kms_key_id = "arn:aws:kms:us-east-2:242306084486:key/9834cc70-67b2-446b-b921-34feb2c33406"
+  }
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_aws_firehose_put_record.test", "delivery_stream_name", "TestDeliveryStream"),
+					resource.TestCheckResourceAttrSet("eventpush_aws_firehose_put_record.test", "record_id"),
+				),
+			},
+			{
+				RefreshState: true,
+			},
+			{
+				Config: config2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_aws_firehose_put_record.test", "delivery_stream_name", "TestDeliveryStream"),
+					resource.TestCheckResourceAttrSet("eventpush_aws_firehose_put_record.test", "record_id"),
+				),
+			},
+		},
+	})
+}
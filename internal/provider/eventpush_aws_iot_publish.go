@@ -0,0 +1,306 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/iotdataplane"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"sort"
+)
+
+var _ resource.Resource = &AWSIoTPublishResource{}
+var _ resource.ResourceWithConfigure = &AWSIoTPublishResource{}
+
+type AWSIoTPublishResource struct {
+	AWSClient *AWSClient
+}
+
+type AWSIoTPublishResourceModel struct {
+	ContentType      types.String                 `tfsdk:"content_type"`
+	CorrelationData  types.String                 `tfsdk:"correlation_data"`
+	CreateOnly       types.Bool                   `tfsdk:"create_only"`
+	EventId          types.String                 `tfsdk:"event_id"`
+	KMSSignature     []KMSSignatureAttributeModel `tfsdk:"kms_signature"`
+	MD5OfMessageBody types.String                 `tfsdk:"md5_of_message_body"`
+	MessageBody      types.String                 `tfsdk:"message_body"`
+	QoS              types.Int32                  `tfsdk:"qos"`
+	Retain           types.Bool                   `tfsdk:"retain"`
+	Topic            types.String                 `tfsdk:"topic"`
+	UserProperties   types.Map                    `tfsdk:"user_properties"`
+}
+
+func newAWSIoTPublishResource() resource.Resource {
+	return &AWSIoTPublishResource{}
+}
+
+func (r *AWSIoTPublishResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerMeta := request.ProviderData.(Meta)
+
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("unable to load SDK config", err.Error())
+		return
+	}
+
+	if providerMeta.AWSConfigOptions.Region != "" {
+		cfg.Region = providerMeta.AWSConfigOptions.Region
+	}
+
+	iotDataClient := iotdataplane.NewFromConfig(cfg, func(o *iotdataplane.Options) {
+		if providerMeta.AWSConfigOptions.IoTDataEndpoint != "" {
+			o.BaseEndpoint = aws.String(providerMeta.AWSConfigOptions.IoTDataEndpoint)
+		}
+	})
+	kmsClient := kms.NewFromConfig(cfg)
+
+	r.AWSClient = &AWSClient{
+		IoTDataClient: iotDataClient,
+		KMSClient:     kmsClient,
+		Region:        providerMeta.AWSConfigOptions.Region,
+	}
+}
+
+func (r *AWSIoTPublishResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_aws_iot_publish"
+}
+
+func (r *AWSIoTPublishResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Publish a message to an AWS IoT Core MQTT topic.",
+		Attributes: map[string]schema.Attribute{
+			"content_type": schema.StringAttribute{
+				Description: "A UTF-8 encoded string that describes the content of the message.",
+				Optional:    true,
+			},
+			"correlation_data": schema.StringAttribute{
+				Description: "Data used by the sender of a request message to identify which request a response message is for.",
+				Optional:    true,
+			},
+			"create_only": schema.BoolAttribute{
+				Description: "When enabled, forces resource to be replaced on update.",
+				Optional:    true,
+			},
+			"event_id": schema.StringAttribute{
+				Description: "Generated ID for resource tracking.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"md5_of_message_body": schema.StringAttribute{
+				Description: "The MD5 of the message body.",
+				Computed:    true,
+			},
+			"message_body": schema.StringAttribute{
+				Description: "The message to send.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(replaceIfCreateOnlySet, "Forces replacement of resource.", "Forces replacement of resource."),
+				},
+			},
+			"qos": schema.Int32Attribute{
+				Description: "The Quality of Service (QoS) level, either 0 or 1. Defaults to 0.",
+				Optional:    true,
+				Validators: []validator.Int32{
+					int32validator.Between(0, 1),
+				},
+			},
+			"retain": schema.BoolAttribute{
+				Description: "When enabled, sets the RETAIN flag so the message is sent to new subscribers of the topic.",
+				Optional:    true,
+			},
+			"topic": schema.StringAttribute{
+				Description: "The name of the MQTT topic.",
+				Required:    true,
+			},
+			"user_properties": schema.MapAttribute{
+				Description: "MQTT5 user properties to send with the message.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"kms_signature": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"kms_key_id": schema.StringAttribute{
+							Description: "The ID of the AWS KMS key.",
+							Required:    true,
+						},
+						"message_attribute": schema.StringAttribute{
+							Description: "User property name to add signature value.",
+							Optional:    true,
+						},
+						"algorithm": schema.StringAttribute{
+							Description: "The KMS signature algorithm.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOfCaseInsensitive(
+									[]string{
+										string(kmstypes.SigningAlgorithmSpecRsassaPkcs1V15Sha256),
+									}...,
+								),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *AWSIoTPublishResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data AWSIoTPublishResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	err := publishIoTMessage(ctx, r.AWSClient, &data, "create")
+	if err != nil {
+		response.Diagnostics.AddError("Error publishing message to IoT topic.", err.Error())
+		return
+	}
+
+	data.EventId = types.StringValue(uuid.New().String())
+	data.MD5OfMessageBody = types.StringValue(createMD5OfMessageBody(data.MessageBody.ValueString()))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *AWSIoTPublishResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data AWSIoTPublishResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *AWSIoTPublishResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state AWSIoTPublishResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	planMessageBodyMD5 := createMD5OfMessageBody(plan.MessageBody.ValueString())
+	stateMessageBodyMD5 := createMD5OfMessageBody(state.MessageBody.ValueString())
+
+	if planMessageBodyMD5 != stateMessageBodyMD5 {
+		err := publishIoTMessage(ctx, r.AWSClient, &plan, "update")
+		if err != nil {
+			response.Diagnostics.AddError("Error publishing message to IoT topic.", err.Error())
+			return
+		}
+	}
+	plan.MD5OfMessageBody = types.StringValue(planMessageBodyMD5)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *AWSIoTPublishResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data AWSIoTPublishResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.CreateOnly.ValueBool() {
+		err := publishIoTMessage(ctx, r.AWSClient, &data, "delete")
+		if err != nil {
+			response.Diagnostics.AddError("Error publishing message to IoT topic.", err.Error())
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func publishIoTMessage(ctx context.Context, meta *AWSClient, data *AWSIoTPublishResourceModel, lifeCycle string) error {
+	userProperties := make(map[string]string)
+	input := &iotdataplane.PublishInput{
+		Topic:   aws.String(data.Topic.ValueString()),
+		Payload: []byte(data.MessageBody.ValueString()),
+		Qos:     data.QoS.ValueInt32(),
+		Retain:  data.Retain.ValueBool(),
+	}
+
+	if !data.ContentType.IsNull() {
+		input.ContentType = aws.String(data.ContentType.ValueString())
+	}
+
+	if !data.CorrelationData.IsNull() {
+		input.CorrelationData = aws.String(base64.StdEncoding.EncodeToString([]byte(data.CorrelationData.ValueString())))
+	}
+
+	if !data.UserProperties.IsNull() {
+		diags := data.UserProperties.ElementsAs(ctx, &userProperties, false)
+		if diags.HasError() {
+			return fmt.Errorf("unable to read user properties")
+		}
+	}
+
+	if data.KMSSignature != nil {
+		attrName, signature, err := signMessageWithKMSSignatureBlock(ctx, meta.KMSClient, data.KMSSignature[0], data.MessageBody.ValueString())
+		if err != nil {
+			return err
+		}
+		userProperties[attrName] = signature
+	}
+
+	userProperties["X-LifeCycle-Event"] = lifeCycle
+
+	// User properties are sent as an array of single key objects, sorted so the order is stable
+	keys := make([]string, 0, len(userProperties))
+	for key := range userProperties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	properties := make([]map[string]string, 0, len(keys))
+	for _, key := range keys {
+		properties = append(properties, map[string]string{key: userProperties[key]})
+	}
+
+	propertiesJSON, err := json.Marshal(properties)
+	if err != nil {
+		return err
+	}
+	input.UserProperties = aws.String(string(propertiesJSON))
+
+	_, err = meta.IoTDataClient.Publish(ctx, input)
+	return err
+}
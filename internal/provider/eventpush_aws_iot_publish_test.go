@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccEventPushIoTPublish_Simple(t *testing.T) {
+	config1 := `
+resource "eventpush_aws_iot_publish" "test" {
+  message_body = "test message 1"
+  topic        = "test/topic"
+  qos          = 1
+  content_type = "text/plain"
+
+  user_properties = {
+    source = "eventpush"
+  }
+}
+`
+
+	config2 := `
+resource "eventpush_aws_iot_publish" "test" {
+  message_body = "test message 2"
+  topic        = "test/topic"
+  qos          = 1
+  content_type = "text/plain"
+
+  user_properties = {
+    source = "eventpush"
+  }
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_aws_iot_publish.test", "topic", "test/topic"),
+				),
+			},
+			{
+				RefreshState: true,
+			},
+			{
+				Config: config2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_aws_iot_publish.test", "topic", "test/topic"),
+				),
+			},
+		},
+	})
+}
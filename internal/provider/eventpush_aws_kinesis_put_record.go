@@ -0,0 +1,307 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &AWSKinesisPutRecordResource{}
+var _ resource.ResourceWithConfigure = &AWSKinesisPutRecordResource{}
+var _ resource.ResourceWithValidateConfig = &AWSKinesisPutRecordResource{}
+
+type AWSKinesisPutRecordResource struct {
+	AWSClient *AWSClient
+}
+
+type AWSKinesisPutRecordResourceModel struct {
+	CreateOnly       types.Bool                   `tfsdk:"create_only"`
+	Envelope         types.Bool                   `tfsdk:"envelope"`
+	EventId          types.String                 `tfsdk:"event_id"`
+	ExplicitHashKey  types.String                 `tfsdk:"explicit_hash_key"`
+	KMSSignature     []KMSSignatureAttributeModel `tfsdk:"kms_signature"`
+	MD5OfMessageBody types.String                 `tfsdk:"md5_of_message_body"`
+	MessageBody      types.String                 `tfsdk:"message_body"`
+	PartitionKey     types.String                 `tfsdk:"partition_key"`
+	SequenceNumber   types.String                 `tfsdk:"sequence_number"`
+	ShardId          types.String                 `tfsdk:"shard_id"`
+	StreamARN        types.String                 `tfsdk:"stream_arn"`
+	StreamName       types.String                 `tfsdk:"stream_name"`
+}
+
+func newAWSKinesisPutRecordResource() resource.Resource {
+	return &AWSKinesisPutRecordResource{}
+}
+
+func (r *AWSKinesisPutRecordResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerMeta := request.ProviderData.(Meta)
+
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("unable to load SDK config", err.Error())
+		return
+	}
+
+	if providerMeta.AWSConfigOptions.Region != "" {
+		cfg.Region = providerMeta.AWSConfigOptions.Region
+	}
+
+	kinesisClient := kinesis.NewFromConfig(cfg)
+	kmsClient := kms.NewFromConfig(cfg)
+
+	r.AWSClient = &AWSClient{
+		KinesisClient: kinesisClient,
+		KMSClient:     kmsClient,
+		Region:        providerMeta.AWSConfigOptions.Region,
+	}
+}
+
+func (r *AWSKinesisPutRecordResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_aws_kinesis_put_record"
+}
+
+func (r *AWSKinesisPutRecordResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Put a record into an AWS Kinesis Data Stream.",
+		Attributes: map[string]schema.Attribute{
+			"create_only": schema.BoolAttribute{
+				Description: "When enabled, forces resource to be replaced on update.",
+				Optional:    true,
+			},
+			"envelope": schema.BoolAttribute{
+				Description: "When enabled, the record is written as a JSON envelope containing the message body, the lifecycle event and the KMS signature. Defaults to true. Disabling it requires `create_only`.",
+				Optional:    true,
+			},
+			"event_id": schema.StringAttribute{
+				Description: "Generated ID for resource tracking.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"explicit_hash_key": schema.StringAttribute{
+				Description: "The hash value used to explicitly determine the shard the record is assigned to, overriding the partition key hash.",
+				Optional:    true,
+			},
+			"md5_of_message_body": schema.StringAttribute{
+				Description: "The MD5 of the message body.",
+				Computed:    true,
+			},
+			"message_body": schema.StringAttribute{
+				Description: "The message to send.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(replaceIfCreateOnlySet, "Forces replacement of resource.", "Forces replacement of resource."),
+				},
+			},
+			"partition_key": schema.StringAttribute{
+				Description: "Determines which shard in the stream the record is assigned to.",
+				Required:    true,
+			},
+			"sequence_number": schema.StringAttribute{
+				Description: "The sequence number assigned to the last record put.",
+				Computed:    true,
+			},
+			"shard_id": schema.StringAttribute{
+				Description: "The shard ID of the shard where the last record was stored.",
+				Computed:    true,
+			},
+			"stream_arn": schema.StringAttribute{
+				Description: "The ARN of the stream.",
+				Optional:    true,
+			},
+			"stream_name": schema.StringAttribute{
+				Description: "The name of the stream.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("stream_arn")),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"kms_signature": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"kms_key_id": schema.StringAttribute{
+							Description: "The ID of the AWS KMS key.",
+							Required:    true,
+						},
+						"message_attribute": schema.StringAttribute{
+							Description: "Envelope field name to add signature value.",
+							Optional:    true,
+						},
+						"algorithm": schema.StringAttribute{
+							Description: "The KMS signature algorithm.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOfCaseInsensitive(
+									[]string{
+										string(kmstypes.SigningAlgorithmSpecRsassaPkcs1V15Sha256),
+									}...,
+								),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *AWSKinesisPutRecordResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data AWSKinesisPutRecordResourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	validateMessageEnvelope(data.Envelope, data.CreateOnly, data.KMSSignature, "Kinesis records", &response.Diagnostics)
+}
+
+func (r *AWSKinesisPutRecordResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data AWSKinesisPutRecordResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	err := putRecord(ctx, r.AWSClient, &data, "create")
+	if err != nil {
+		response.Diagnostics.AddError("Error putting record to Kinesis stream.", err.Error())
+		return
+	}
+
+	data.EventId = types.StringValue(uuid.New().String())
+	data.MD5OfMessageBody = types.StringValue(createMD5OfMessageBody(data.MessageBody.ValueString()))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *AWSKinesisPutRecordResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data AWSKinesisPutRecordResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *AWSKinesisPutRecordResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state AWSKinesisPutRecordResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	planMessageBodyMD5 := createMD5OfMessageBody(plan.MessageBody.ValueString())
+	stateMessageBodyMD5 := createMD5OfMessageBody(state.MessageBody.ValueString())
+
+	if planMessageBodyMD5 != stateMessageBodyMD5 {
+		err := putRecord(ctx, r.AWSClient, &plan, "update")
+		if err != nil {
+			response.Diagnostics.AddError("Error putting record to Kinesis stream.", err.Error())
+			return
+		}
+	} else {
+		plan.SequenceNumber = state.SequenceNumber
+		plan.ShardId = state.ShardId
+	}
+	plan.MD5OfMessageBody = types.StringValue(planMessageBodyMD5)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *AWSKinesisPutRecordResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data AWSKinesisPutRecordResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.CreateOnly.ValueBool() {
+		err := putRecord(ctx, r.AWSClient, &data, "delete")
+		if err != nil {
+			response.Diagnostics.AddError("Error putting record to Kinesis stream.", err.Error())
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func putRecord(ctx context.Context, meta *AWSClient, data *AWSKinesisPutRecordResourceModel, lifeCycle string) error {
+	recordData := []byte(data.MessageBody.ValueString())
+
+	// Kinesis records have no attributes, so the lifecycle event and signature are carried in an envelope
+	if messageEnvelopeEnabled(data.Envelope) {
+		envelope, err := createMessageEnvelope(ctx, meta.KMSClient, data.KMSSignature, data.MessageBody.ValueString(), lifeCycle)
+		if err != nil {
+			return err
+		}
+
+		recordData, err = json.Marshal(envelope)
+		if err != nil {
+			return err
+		}
+	}
+
+	input := &kinesis.PutRecordInput{
+		Data:         recordData,
+		PartitionKey: aws.String(data.PartitionKey.ValueString()),
+	}
+
+	if !data.StreamName.IsNull() {
+		input.StreamName = aws.String(data.StreamName.ValueString())
+	}
+
+	if !data.StreamARN.IsNull() {
+		input.StreamARN = aws.String(data.StreamARN.ValueString())
+	}
+
+	if !data.ExplicitHashKey.IsNull() {
+		input.ExplicitHashKey = aws.String(data.ExplicitHashKey.ValueString())
+	}
+
+	output, err := meta.KinesisClient.PutRecord(ctx, input)
+	if err != nil {
+		return err
+	}
+
+	data.SequenceNumber = types.StringPointerValue(output.SequenceNumber)
+	data.ShardId = types.StringPointerValue(output.ShardId)
+
+	return nil
+}
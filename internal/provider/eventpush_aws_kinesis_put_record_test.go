@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccEventPushKinesisPutRecord_Simple(t *testing.T) {
+	config1 := `
+resource "eventpush_aws_kinesis_put_record" "test" {
+  message_body  = "test message 1"
+  stream_name   = "TestStream"
+  partition_key = "test"
+}
+`
+
+	config2 := `
+resource "eventpush_aws_kinesis_put_record" "test" {
+  message_body  = "test message 2"
+  stream_name   = "TestStream"
+  partition_key = "test"
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_aws_kinesis_put_record.test", "stream_name", "TestStream"),
+					resource.TestCheckResourceAttrSet("eventpush_aws_kinesis_put_record.test", "sequence_number"),
+				),
+			},
+			{
+				RefreshState: true,
+			},
+			{
+				Config: config2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_aws_kinesis_put_record.test", "stream_name", "TestStream"),
+					resource.TestCheckResourceAttrSet("eventpush_aws_kinesis_put_record.test", "sequence_number"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEventPushKinesisPutRecord_Envelope(t *testing.T) {
+	config1 := `
+resource "eventpush_aws_kinesis_put_record" "test" {
+  message_body  = "test message 1"
+  stream_arn    = "arn:aws:kinesis:us-east-2:242306084486:stream/TestStream"
+  partition_key = "test"
+  envelope      = true
+
+  kms_signature {
+    kms_key_id = "arn:aws:kms:us-east-2:242306084486:key/9834cc70-67b2-446b-b921-34feb2c33406"
+  }
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_aws_kinesis_put_record.test", "envelope", "true"),
+				),
+			},
+			{
+				Config:  config1,
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func TestAccEventPushKinesisPutRecord_WithoutEnvelope(t *testing.T) {
+	config1 := `
+resource "eventpush_aws_kinesis_put_record" "test" {
+  message_body  = "test message 1"
+  stream_name   = "TestStream"
+  partition_key = "test"
+  envelope      = false
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config1,
+				ExpectError: regexp.MustCompile("Missing envelope"),
+			},
+		},
+	})
+}
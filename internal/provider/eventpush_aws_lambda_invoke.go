@@ -0,0 +1,282 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	lambdatypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &AWSLambdaInvokeResource{}
+var _ resource.ResourceWithConfigure = &AWSLambdaInvokeResource{}
+
+type AWSLambdaInvokeResource struct {
+	AWSClient *AWSClient
+}
+
+type AWSLambdaInvokeResourceModel struct {
+	CreateOnly      types.Bool   `tfsdk:"create_only"`
+	EventId         types.String `tfsdk:"event_id"`
+	ExecutedVersion types.String `tfsdk:"executed_version"`
+	FunctionError   types.String `tfsdk:"function_error"`
+	FunctionName    types.String `tfsdk:"function_name"`
+	InvocationType  types.String `tfsdk:"invocation_type"`
+	LifeCycleKey    types.String `tfsdk:"lifecycle_key"`
+	MD5OfPayload    types.String `tfsdk:"md5_of_payload"`
+	Payload         types.String `tfsdk:"payload"`
+	Qualifier       types.String `tfsdk:"qualifier"`
+	ResponsePayload types.String `tfsdk:"response_payload"`
+	StatusCode      types.Int32  `tfsdk:"status_code"`
+}
+
+func newAWSLambdaInvokeResource() resource.Resource {
+	return &AWSLambdaInvokeResource{}
+}
+
+func (r *AWSLambdaInvokeResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerMeta := request.ProviderData.(Meta)
+
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("unable to load SDK config", err.Error())
+		return
+	}
+
+	if providerMeta.AWSConfigOptions.Region != "" {
+		cfg.Region = providerMeta.AWSConfigOptions.Region
+	}
+
+	lambdaClient := lambda.NewFromConfig(cfg)
+
+	r.AWSClient = &AWSClient{
+		LambdaClient: lambdaClient,
+		Region:       providerMeta.AWSConfigOptions.Region,
+	}
+}
+
+func (r *AWSLambdaInvokeResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_aws_lambda_invoke"
+}
+
+func (r *AWSLambdaInvokeResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Invoke an AWS Lambda function.",
+		Attributes: map[string]schema.Attribute{
+			"create_only": schema.BoolAttribute{
+				Description: "When enabled, forces resource to be replaced on update.",
+				Optional:    true,
+			},
+			"event_id": schema.StringAttribute{
+				Description: "Generated ID for resource tracking.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"executed_version": schema.StringAttribute{
+				Description: "The version of the function that executed the last synchronous invocation.",
+				Computed:    true,
+			},
+			"function_error": schema.StringAttribute{
+				Description: "The error type returned by the last synchronous invocation, if any.",
+				Computed:    true,
+			},
+			"function_name": schema.StringAttribute{
+				Description: "The name, ARN or partial ARN of the Lambda function.",
+				Required:    true,
+			},
+			"invocation_type": schema.StringAttribute{
+				Description: "Either `RequestResponse` to invoke the function synchronously or `Event` to invoke it asynchronously. Defaults to `RequestResponse`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						[]string{
+							string(lambdatypes.InvocationTypeRequestResponse),
+							string(lambdatypes.InvocationTypeEvent),
+						}...,
+					),
+				},
+			},
+			"lifecycle_key": schema.StringAttribute{
+				Description: "The payload key the lifecycle event is added under. Defaults to `X-LifeCycle-Event`.",
+				Optional:    true,
+			},
+			"md5_of_payload": schema.StringAttribute{
+				Description: "The MD5 of the payload.",
+				Computed:    true,
+			},
+			"payload": schema.StringAttribute{
+				Description: "A valid JSON object to provide as input to the function.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(replaceIfCreateOnlySet, "Forces replacement of resource.", "Forces replacement of resource."),
+				},
+			},
+			"qualifier": schema.StringAttribute{
+				Description: "A version or alias of the function to invoke.",
+				Optional:    true,
+			},
+			"response_payload": schema.StringAttribute{
+				Description: "The response from the function returned by the last synchronous invocation.",
+				Computed:    true,
+			},
+			"status_code": schema.Int32Attribute{
+				Description: "The HTTP status code returned by the last invocation.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *AWSLambdaInvokeResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data AWSLambdaInvokeResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	err := invokeFunction(ctx, r.AWSClient, &data, "create")
+	if err != nil {
+		response.Diagnostics.AddError("Error invoking Lambda function.", err.Error())
+		return
+	}
+
+	data.EventId = types.StringValue(uuid.New().String())
+	data.MD5OfPayload = types.StringValue(createMD5OfMessageBody(data.Payload.ValueString()))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *AWSLambdaInvokeResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data AWSLambdaInvokeResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *AWSLambdaInvokeResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state AWSLambdaInvokeResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	planPayloadMD5 := createMD5OfMessageBody(plan.Payload.ValueString())
+	statePayloadMD5 := createMD5OfMessageBody(state.Payload.ValueString())
+
+	if planPayloadMD5 != statePayloadMD5 {
+		err := invokeFunction(ctx, r.AWSClient, &plan, "update")
+		if err != nil {
+			response.Diagnostics.AddError("Error invoking Lambda function.", err.Error())
+			return
+		}
+	} else {
+		plan.ExecutedVersion = state.ExecutedVersion
+		plan.FunctionError = state.FunctionError
+		plan.ResponsePayload = state.ResponsePayload
+		plan.StatusCode = state.StatusCode
+	}
+	plan.MD5OfPayload = types.StringValue(planPayloadMD5)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *AWSLambdaInvokeResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data AWSLambdaInvokeResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.CreateOnly.ValueBool() {
+		err := invokeFunction(ctx, r.AWSClient, &data, "delete")
+		if err != nil {
+			response.Diagnostics.AddError("Error invoking Lambda function.", err.Error())
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func invokeFunction(ctx context.Context, meta *AWSClient, data *AWSLambdaInvokeResourceModel, lifeCycle string) error {
+	var payload map[string]any
+	if err := json.Unmarshal([]byte(data.Payload.ValueString()), &payload); err != nil {
+		return fmt.Errorf("payload must be a valid JSON object: %w", err)
+	}
+	if payload == nil {
+		return fmt.Errorf("payload must be a valid JSON object")
+	}
+
+	lifeCycleKey := "X-LifeCycle-Event"
+	if !data.LifeCycleKey.IsNull() {
+		lifeCycleKey = data.LifeCycleKey.ValueString()
+	}
+	payload[lifeCycleKey] = lifeCycle
+
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	invocationType := lambdatypes.InvocationTypeRequestResponse
+	if !data.InvocationType.IsNull() {
+		invocationType = lambdatypes.InvocationType(data.InvocationType.ValueString())
+	}
+
+	input := &lambda.InvokeInput{
+		FunctionName:   aws.String(data.FunctionName.ValueString()),
+		InvocationType: invocationType,
+		Payload:        payloadJSON,
+	}
+
+	if !data.Qualifier.IsNull() {
+		input.Qualifier = aws.String(data.Qualifier.ValueString())
+	}
+
+	output, err := meta.LambdaClient.Invoke(ctx, input)
+	if err != nil {
+		return err
+	}
+
+	data.StatusCode = types.Int32Value(output.StatusCode)
+	data.ExecutedVersion = types.StringPointerValue(output.ExecutedVersion)
+	data.FunctionError = types.StringPointerValue(output.FunctionError)
+	data.ResponsePayload = types.StringNull()
+	if invocationType == lambdatypes.InvocationTypeRequestResponse {
+		data.ResponsePayload = types.StringValue(string(output.Payload))
+	}
+
+	if output.FunctionError != nil {
+		return fmt.Errorf("function returned %s error: %s", aws.ToString(output.FunctionError), string(output.Payload))
+	}
+
+	return nil
+}
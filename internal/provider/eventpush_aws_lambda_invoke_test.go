@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccEventPushLambdaInvoke_Simple(t *testing.T) {
+	config1 := `
+resource "eventpush_aws_lambda_invoke" "test" {
+  function_name = "TestFunction"
+  payload       = jsonencode({ message = "test message 1" })
+}
+`
+
+	config2 := `
+resource "eventpush_aws_lambda_invoke" "test" {
+  function_name = "TestFunction"
+  payload       = jsonencode({ message = "test message 2" })
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_aws_lambda_invoke.test", "status_code", "200"),
+					resource.TestCheckResourceAttrSet("eventpush_aws_lambda_invoke.test", "response_payload"),
+				),
+			},
+			{
+				RefreshState: true,
+			},
+			{
+				Config: config2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_aws_lambda_invoke.test", "status_code", "200"),
+					resource.TestCheckResourceAttrSet("eventpush_aws_lambda_invoke.test", "response_payload"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEventPushLambdaInvoke_Event(t *testing.T) {
+	config1 := `
+resource "eventpush_aws_lambda_invoke" "test" {
+  function_name   = "TestFunction"
+  invocation_type = "Event"
+  payload         = jsonencode({ message = "test message 1" })
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_aws_lambda_invoke.test", "status_code", "202"),
+				),
+			},
+			{
+				Config:  config1,
+				Destroy: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,348 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/url"
+	"strings"
+	"time"
+)
+
+var _ resource.Resource = &AWSS3EventObjectResource{}
+var _ resource.ResourceWithConfigure = &AWSS3EventObjectResource{}
+
+const s3EventObjectDefaultKeyTemplate = "events/{event_id}/{lifecycle}-{timestamp}"
+
+type AWSS3EventObjectResource struct {
+	AWSClient *AWSClient
+}
+
+type AWSS3EventObjectResourceModel struct {
+	Bucket            types.String                 `tfsdk:"bucket"`
+	ChecksumAlgorithm types.String                 `tfsdk:"checksum_algorithm"`
+	ContentType       types.String                 `tfsdk:"content_type"`
+	CreateOnly        types.Bool                   `tfsdk:"create_only"`
+	ETag              types.String                 `tfsdk:"etag"`
+	EventId           types.String                 `tfsdk:"event_id"`
+	KeyTemplate       types.String                 `tfsdk:"key_template"`
+	KMSSignature      []KMSSignatureAttributeModel `tfsdk:"kms_signature"`
+	MD5OfMessageBody  types.String                 `tfsdk:"md5_of_message_body"`
+	MessageBody       types.String                 `tfsdk:"message_body"`
+	ObjectKey         types.String                 `tfsdk:"object_key"`
+	SSEKMSKeyId       types.String                 `tfsdk:"sse_kms_key_id"`
+	Tags              types.Map                    `tfsdk:"tags"`
+	VersionId         types.String                 `tfsdk:"version_id"`
+}
+
+func newAWSS3EventObjectResource() resource.Resource {
+	return &AWSS3EventObjectResource{}
+}
+
+func (r *AWSS3EventObjectResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerMeta := request.ProviderData.(Meta)
+
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("unable to load SDK config", err.Error())
+		return
+	}
+
+	if providerMeta.AWSConfigOptions.Region != "" {
+		cfg.Region = providerMeta.AWSConfigOptions.Region
+	}
+
+	s3Client := s3.NewFromConfig(cfg)
+	kmsClient := kms.NewFromConfig(cfg)
+
+	r.AWSClient = &AWSClient{
+		S3Client:  s3Client,
+		KMSClient: kmsClient,
+		Region:    providerMeta.AWSConfigOptions.Region,
+	}
+}
+
+func (r *AWSS3EventObjectResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_aws_s3_event_object"
+}
+
+func (r *AWSS3EventObjectResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Write a message as an object to an AWS S3 bucket.",
+		Attributes: map[string]schema.Attribute{
+			"bucket": schema.StringAttribute{
+				Description: "The name of the bucket to write the object to.",
+				Required:    true,
+			},
+			"checksum_algorithm": schema.StringAttribute{
+				Description: "The algorithm used to create the checksum for the object.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						[]string{
+							string(s3types.ChecksumAlgorithmCrc32),
+							string(s3types.ChecksumAlgorithmCrc32c),
+							string(s3types.ChecksumAlgorithmCrc64nvme),
+							string(s3types.ChecksumAlgorithmSha1),
+							string(s3types.ChecksumAlgorithmSha256),
+						}...,
+					),
+				},
+			},
+			"content_type": schema.StringAttribute{
+				Description: "A standard MIME type describing the format of the object data.",
+				Optional:    true,
+			},
+			"create_only": schema.BoolAttribute{
+				Description: "When enabled, forces resource to be replaced on update.",
+				Optional:    true,
+			},
+			"etag": schema.StringAttribute{
+				Description: "The entity tag of the last object written.",
+				Computed:    true,
+			},
+			"event_id": schema.StringAttribute{
+				Description: "Generated ID for resource tracking.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key_template": schema.StringAttribute{
+				Description: "The template used to build the object key. Supports the `{event_id}`, `{lifecycle}` and `{timestamp}` placeholders. Defaults to `" + s3EventObjectDefaultKeyTemplate + "`.",
+				Optional:    true,
+			},
+			"md5_of_message_body": schema.StringAttribute{
+				Description: "The MD5 of the message body.",
+				Computed:    true,
+			},
+			"message_body": schema.StringAttribute{
+				Description: "The message to send.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(replaceIfCreateOnlySet, "Forces replacement of resource.", "Forces replacement of resource."),
+				},
+			},
+			"object_key": schema.StringAttribute{
+				Description: "The key of the last object written.",
+				Computed:    true,
+			},
+			"sse_kms_key_id": schema.StringAttribute{
+				Description: "The ID of the AWS KMS key used to encrypt the object with SSE-KMS.",
+				Optional:    true,
+			},
+			"tags": schema.MapAttribute{
+				Description: "Tags to set on the object.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"version_id": schema.StringAttribute{
+				Description: "The version ID of the last object written, when versioning is enabled on the bucket.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"kms_signature": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"kms_key_id": schema.StringAttribute{
+							Description: "The ID of the AWS KMS key.",
+							Required:    true,
+						},
+						"message_attribute": schema.StringAttribute{
+							Description: "Object metadata name to add signature value.",
+							Optional:    true,
+						},
+						"algorithm": schema.StringAttribute{
+							Description: "The KMS signature algorithm.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOfCaseInsensitive(
+									[]string{
+										string(kmstypes.SigningAlgorithmSpecRsassaPkcs1V15Sha256),
+									}...,
+								),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *AWSS3EventObjectResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data AWSS3EventObjectResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Event ID is set before writing since it is used in the object key
+	data.EventId = types.StringValue(uuid.New().String())
+
+	err := putEventObject(ctx, r.AWSClient, &data, "create")
+	if err != nil {
+		response.Diagnostics.AddError("Error writing object to S3 bucket.", err.Error())
+		return
+	}
+
+	data.MD5OfMessageBody = types.StringValue(createMD5OfMessageBody(data.MessageBody.ValueString()))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *AWSS3EventObjectResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data AWSS3EventObjectResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *AWSS3EventObjectResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state AWSS3EventObjectResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	planMessageBodyMD5 := createMD5OfMessageBody(plan.MessageBody.ValueString())
+	stateMessageBodyMD5 := createMD5OfMessageBody(state.MessageBody.ValueString())
+
+	if planMessageBodyMD5 != stateMessageBodyMD5 {
+		err := putEventObject(ctx, r.AWSClient, &plan, "update")
+		if err != nil {
+			response.Diagnostics.AddError("Error writing object to S3 bucket.", err.Error())
+			return
+		}
+	} else {
+		plan.ETag = state.ETag
+		plan.ObjectKey = state.ObjectKey
+		plan.VersionId = state.VersionId
+	}
+	plan.MD5OfMessageBody = types.StringValue(planMessageBodyMD5)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *AWSS3EventObjectResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data AWSS3EventObjectResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Earlier objects are kept, a delete marker object is written instead
+	if !data.CreateOnly.ValueBool() {
+		err := putEventObject(ctx, r.AWSClient, &data, "delete")
+		if err != nil {
+			response.Diagnostics.AddError("Error writing object to S3 bucket.", err.Error())
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func putEventObject(ctx context.Context, meta *AWSClient, data *AWSS3EventObjectResourceModel, lifeCycle string) error {
+	keyTemplate := s3EventObjectDefaultKeyTemplate
+	if !data.KeyTemplate.IsNull() {
+		keyTemplate = data.KeyTemplate.ValueString()
+	}
+
+	key := strings.NewReplacer(
+		"{event_id}", data.EventId.ValueString(),
+		"{lifecycle}", lifeCycle,
+		"{timestamp}", time.Now().UTC().Format("20060102T150405Z"),
+	).Replace(keyTemplate)
+
+	metadata := map[string]string{
+		"X-LifeCycle-Event": lifeCycle,
+	}
+
+	if data.KMSSignature != nil {
+		attrName, signature, err := signMessageWithKMSSignatureBlock(ctx, meta.KMSClient, data.KMSSignature[0], data.MessageBody.ValueString())
+		if err != nil {
+			return err
+		}
+		metadata[attrName] = signature
+	}
+
+	input := &s3.PutObjectInput{
+		Bucket:   aws.String(data.Bucket.ValueString()),
+		Key:      aws.String(key),
+		Body:     strings.NewReader(data.MessageBody.ValueString()),
+		Metadata: metadata,
+	}
+
+	if !data.ContentType.IsNull() {
+		input.ContentType = aws.String(data.ContentType.ValueString())
+	}
+
+	if !data.ChecksumAlgorithm.IsNull() {
+		input.ChecksumAlgorithm = s3types.ChecksumAlgorithm(data.ChecksumAlgorithm.ValueString())
+	}
+
+	if !data.SSEKMSKeyId.IsNull() {
+		input.ServerSideEncryption = s3types.ServerSideEncryptionAwsKms
+		input.SSEKMSKeyId = aws.String(data.SSEKMSKeyId.ValueString())
+	}
+
+	if !data.Tags.IsNull() {
+		tags := make(map[string]string)
+		diags := data.Tags.ElementsAs(ctx, &tags, false)
+		if diags.HasError() {
+			return fmt.Errorf("unable to read tags")
+		}
+
+		tagging := url.Values{}
+		for tagKey, tagValue := range tags {
+			tagging.Set(tagKey, tagValue)
+		}
+		input.Tagging = aws.String(tagging.Encode())
+	}
+
+	output, err := meta.S3Client.PutObject(ctx, input)
+	if err != nil {
+		return err
+	}
+
+	data.ETag = types.StringPointerValue(output.ETag)
+	data.ObjectKey = types.StringValue(key)
+	data.VersionId = types.StringPointerValue(output.VersionId)
+
+	return nil
+}
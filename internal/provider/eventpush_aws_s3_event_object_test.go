@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccEventPushS3EventObject_Simple(t *testing.T) {
+	config1 := `
+resource "eventpush_aws_s3_event_object" "test" {
+  bucket             = "eventpush-test-bucket"
+  message_body       = "test message 1"
+  content_type       = "text/plain"
+  checksum_algorithm = "SHA256"
+
+  tags = {
+    Source = "eventpush"
+  }
+}
+`
+
+	config2 := `
+resource "eventpush_aws_s3_event_object" "test" {
+  bucket             = "eventpush-test-bucket"
+  message_body       = "test message 2"
+  content_type       = "text/plain"
+  checksum_algorithm = "SHA256"
+
+  tags = {
+    Source = "eventpush"
+  }
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_aws_s3_event_object.test", "bucket", "eventpush-test-bucket"),
+					resource.TestCheckResourceAttrSet("eventpush_aws_s3_event_object.test", "object_key"),
+				),
+			},
+			{
+				RefreshState: true,
+			},
+			{
+				Config: config2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_aws_s3_event_object.test", "bucket", "eventpush-test-bucket"),
+					resource.TestCheckResourceAttrSet("eventpush_aws_s3_event_object.test", "object_key"),
+				),
+			},
+			{
+				Config:  config2,
+				Destroy: true,
+			},
+		},
+	})
+}
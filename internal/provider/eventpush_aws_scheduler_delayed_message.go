@@ -0,0 +1,460 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	schedulertypes "github.com/aws/aws-sdk-go-v2/service/scheduler/types"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"time"
+)
+
+var _ resource.Resource = &AWSSchedulerDelayedMessageResource{}
+var _ resource.ResourceWithConfigure = &AWSSchedulerDelayedMessageResource{}
+var _ resource.ResourceWithValidateConfig = &AWSSchedulerDelayedMessageResource{}
+
+// Scheduler at() expressions take a local date and time without an offset
+const schedulerAtExpressionLayout = "2006-01-02T15:04:05"
+
+type AWSSchedulerDelayedMessageResource struct {
+	AWSClient *AWSClient
+}
+
+type AWSSchedulerDelayedMessageResourceModel struct {
+	CreateOnly        types.Bool                   `tfsdk:"create_only"`
+	Delay             types.String                 `tfsdk:"delay"`
+	DeliverAt         types.String                 `tfsdk:"deliver_at"`
+	Envelope          types.Bool                   `tfsdk:"envelope"`
+	EventId           types.String                 `tfsdk:"event_id"`
+	FireTime          types.String                 `tfsdk:"fire_time"`
+	KMSSignature      []KMSSignatureAttributeModel `tfsdk:"kms_signature"`
+	MD5OfMessageBody  types.String                 `tfsdk:"md5_of_message_body"`
+	MessageBody       types.String                 `tfsdk:"message_body"`
+	MessageGroupId    types.String                 `tfsdk:"message_group_id"`
+	RoleARN           types.String                 `tfsdk:"role_arn"`
+	ScheduleARN       types.String                 `tfsdk:"schedule_arn"`
+	ScheduleGroupName types.String                 `tfsdk:"schedule_group_name"`
+	ScheduleName      types.String                 `tfsdk:"schedule_name"`
+	TargetARN         types.String                 `tfsdk:"target_arn"`
+}
+
+func newAWSSchedulerDelayedMessageResource() resource.Resource {
+	return &AWSSchedulerDelayedMessageResource{}
+}
+
+func (r *AWSSchedulerDelayedMessageResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerMeta := request.ProviderData.(Meta)
+
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("unable to load SDK config", err.Error())
+		return
+	}
+
+	if providerMeta.AWSConfigOptions.Region != "" {
+		cfg.Region = providerMeta.AWSConfigOptions.Region
+	}
+
+	schedulerClient := scheduler.NewFromConfig(cfg)
+	kmsClient := kms.NewFromConfig(cfg)
+
+	r.AWSClient = &AWSClient{
+		SchedulerClient: schedulerClient,
+		KMSClient:       kmsClient,
+		Region:          providerMeta.AWSConfigOptions.Region,
+	}
+}
+
+func (r *AWSSchedulerDelayedMessageResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_aws_scheduler_delayed_message"
+}
+
+func (r *AWSSchedulerDelayedMessageResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Deliver a message to an AWS SQS queue or SNS topic at a later time using a one-time AWS EventBridge Scheduler schedule.",
+		Attributes: map[string]schema.Attribute{
+			"create_only": schema.BoolAttribute{
+				Description: "When enabled, forces resource to be replaced on update.",
+				Optional:    true,
+			},
+			"delay": schema.StringAttribute{
+				Description: "How long after apply to deliver the message, as a duration such as `72h`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("deliver_at")),
+				},
+			},
+			"deliver_at": schema.StringAttribute{
+				Description: "The time to deliver the message, in RFC 3339 format.",
+				Optional:    true,
+			},
+			"envelope": schema.BoolAttribute{
+				Description: "When enabled, wraps the message body in a JSON envelope carrying the lifecycle event and signature. Defaults to true. Disabling it requires `create_only`.",
+				Optional:    true,
+			},
+			"event_id": schema.StringAttribute{
+				Description: "Generated ID for resource tracking.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"fire_time": schema.StringAttribute{
+				Description: "The time the schedule fires, in RFC 3339 format. A pending schedule keeps its fire time until `delay` or `deliver_at` changes.",
+				Computed:    true,
+			},
+			"md5_of_message_body": schema.StringAttribute{
+				Description: "The MD5 of the message body.",
+				Computed:    true,
+			},
+			"message_body": schema.StringAttribute{
+				Description: "The message to send.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(replaceIfCreateOnlySet, "Forces replacement of resource.", "Forces replacement of resource."),
+				},
+			},
+			"message_group_id": schema.StringAttribute{
+				Description: "The tag that specifies that a message belongs to a specific message group. Required for FIFO queue targets.",
+				Optional:    true,
+			},
+			"role_arn": schema.StringAttribute{
+				Description: "The ARN of the IAM role EventBridge Scheduler uses to send the message.",
+				Required:    true,
+			},
+			"schedule_arn": schema.StringAttribute{
+				Description: "The ARN of the schedule.",
+				Computed:    true,
+			},
+			"schedule_group_name": schema.StringAttribute{
+				Description: "The name of the schedule group. Defaults to the `default` group.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schedule_name": schema.StringAttribute{
+				Description: "The name of the schedule.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"target_arn": schema.StringAttribute{
+				Description: "The ARN of the SQS queue or SNS topic to deliver the message to.",
+				Required:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"kms_signature": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"kms_key_id": schema.StringAttribute{
+							Description: "The ID of the AWS KMS key.",
+							Required:    true,
+						},
+						"message_attribute": schema.StringAttribute{
+							Description: "Envelope field name to add signature value.",
+							Optional:    true,
+						},
+						"algorithm": schema.StringAttribute{
+							Description: "The KMS signature algorithm.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOfCaseInsensitive(
+									[]string{
+										string(kmstypes.SigningAlgorithmSpecRsassaPkcs1V15Sha256),
+									}...,
+								),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *AWSSchedulerDelayedMessageResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data AWSSchedulerDelayedMessageResourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	validateMessageEnvelope(data.Envelope, data.CreateOnly, data.KMSSignature, "Scheduled messages", &response.Diagnostics)
+
+	if !data.Delay.IsNull() && !data.Delay.IsUnknown() {
+		delay, err := time.ParseDuration(data.Delay.ValueString())
+		if err != nil || delay <= 0 {
+			response.Diagnostics.AddAttributeError(
+				path.Root("delay"),
+				"Invalid delay",
+				"The delay must be a positive duration such as 30m or 72h.",
+			)
+		}
+	}
+
+	if !data.DeliverAt.IsNull() && !data.DeliverAt.IsUnknown() {
+		if _, err := time.Parse(time.RFC3339, data.DeliverAt.ValueString()); err != nil {
+			response.Diagnostics.AddAttributeError(
+				path.Root("deliver_at"),
+				"Invalid delivery time",
+				"The delivery time must be in RFC 3339 format, such as 2030-01-02T15:04:05Z.",
+			)
+		}
+	}
+
+	if !data.TargetARN.IsNull() && !data.TargetARN.IsUnknown() {
+		targetARN, err := arn.Parse(data.TargetARN.ValueString())
+		if err != nil || (targetARN.Service != "sqs" && targetARN.Service != "sns") {
+			response.Diagnostics.AddAttributeError(
+				path.Root("target_arn"),
+				"Invalid target",
+				"The target must be the ARN of an SQS queue or SNS topic.",
+			)
+		} else if targetARN.Service == "sqs" && isFIFOQueue(targetARN.Resource) && data.MessageGroupId.IsNull() {
+			response.Diagnostics.AddAttributeError(
+				path.Root("message_group_id"),
+				"Missing message group ID",
+				"Messages sent to a FIFO queue require a message group ID.",
+			)
+		}
+	}
+}
+
+func (r *AWSSchedulerDelayedMessageResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data AWSSchedulerDelayedMessageResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Event ID is set before scheduling since it is used in the schedule name
+	data.EventId = types.StringValue(uuid.New().String())
+	data.ScheduleName = types.StringValue(fmt.Sprintf("eventpush-%s", data.EventId.ValueString()))
+
+	err := putSchedule(ctx, r.AWSClient, &data, "create")
+	if err != nil {
+		response.Diagnostics.AddError("Error creating EventBridge Scheduler schedule.", err.Error())
+		return
+	}
+
+	data.MD5OfMessageBody = types.StringValue(createMD5OfMessageBody(data.MessageBody.ValueString()))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *AWSSchedulerDelayedMessageResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data AWSSchedulerDelayedMessageResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *AWSSchedulerDelayedMessageResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state AWSSchedulerDelayedMessageResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	planMessageBodyMD5 := createMD5OfMessageBody(plan.MessageBody.ValueString())
+	stateMessageBodyMD5 := createMD5OfMessageBody(state.MessageBody.ValueString())
+
+	// A pending schedule is also updated when its timing or target changes
+	timingChanged := !plan.Delay.Equal(state.Delay) || !plan.DeliverAt.Equal(state.DeliverAt)
+	targetChanged := !plan.TargetARN.Equal(state.TargetARN) || !plan.RoleARN.Equal(state.RoleARN)
+
+	// The fire time is only recalculated when the timing changes, otherwise a delay would restart on every update
+	if !timingChanged {
+		plan.FireTime = state.FireTime
+	}
+
+	if planMessageBodyMD5 != stateMessageBodyMD5 || timingChanged || targetChanged {
+		err := putSchedule(ctx, r.AWSClient, &plan, "update")
+		if err != nil {
+			response.Diagnostics.AddError("Error updating EventBridge Scheduler schedule.", err.Error())
+			return
+		}
+	} else {
+		plan.ScheduleARN = state.ScheduleARN
+	}
+	plan.MD5OfMessageBody = types.StringValue(planMessageBodyMD5)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *AWSSchedulerDelayedMessageResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data AWSSchedulerDelayedMessageResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Schedules delete themselves after firing, so a missing schedule means the message was already delivered
+	input := &scheduler.DeleteScheduleInput{
+		Name: aws.String(data.ScheduleName.ValueString()),
+	}
+
+	if !data.ScheduleGroupName.IsNull() {
+		input.GroupName = aws.String(data.ScheduleGroupName.ValueString())
+	}
+
+	_, err := r.AWSClient.SchedulerClient.DeleteSchedule(ctx, input)
+	if err != nil {
+		var notFound *schedulertypes.ResourceNotFoundException
+		if !errors.As(err, &notFound) {
+			response.Diagnostics.AddError("Error deleting EventBridge Scheduler schedule.", err.Error())
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func putSchedule(ctx context.Context, meta *AWSClient, data *AWSSchedulerDelayedMessageResourceModel, lifeCycle string) error {
+	var fireTime time.Time
+	if !data.FireTime.IsNull() && !data.FireTime.IsUnknown() {
+		storedFireTime, err := time.Parse(time.RFC3339, data.FireTime.ValueString())
+		if err != nil {
+			return err
+		}
+		fireTime = storedFireTime
+	}
+
+	// A pending schedule keeps its fire time, while a schedule that has already fired is timed again from now
+	if !fireTime.After(time.Now()) {
+		if !data.DeliverAt.IsNull() {
+			deliverAt, err := time.Parse(time.RFC3339, data.DeliverAt.ValueString())
+			if err != nil {
+				return err
+			}
+			fireTime = deliverAt.UTC()
+		} else {
+			delay, err := time.ParseDuration(data.Delay.ValueString())
+			if err != nil {
+				return err
+			}
+			fireTime = time.Now().UTC().Add(delay)
+		}
+	}
+
+	messageBody := data.MessageBody.ValueString()
+
+	// Scheduled messages have no attributes, so the lifecycle event and signature are carried in an envelope
+	if messageEnvelopeEnabled(data.Envelope) {
+		envelope, err := createMessageEnvelope(ctx, meta.KMSClient, data.KMSSignature, data.MessageBody.ValueString(), lifeCycle)
+		if err != nil {
+			return err
+		}
+
+		envelopeJSON, err := json.Marshal(envelope)
+		if err != nil {
+			return err
+		}
+		messageBody = string(envelopeJSON)
+	}
+
+	target := &schedulertypes.Target{
+		Arn:     aws.String(data.TargetARN.ValueString()),
+		RoleArn: aws.String(data.RoleARN.ValueString()),
+		Input:   aws.String(messageBody),
+	}
+
+	if !data.MessageGroupId.IsNull() {
+		target.SqsParameters = &schedulertypes.SqsParameters{
+			MessageGroupId: aws.String(data.MessageGroupId.ValueString()),
+		}
+	}
+
+	var groupName *string
+	if !data.ScheduleGroupName.IsNull() {
+		groupName = aws.String(data.ScheduleGroupName.ValueString())
+	}
+
+	scheduleExpression := fmt.Sprintf("at(%s)", fireTime.Format(schedulerAtExpressionLayout))
+	flexibleTimeWindow := &schedulertypes.FlexibleTimeWindow{
+		Mode: schedulertypes.FlexibleTimeWindowModeOff,
+	}
+
+	var scheduleARN *string
+	if lifeCycle == "update" {
+		output, err := meta.SchedulerClient.UpdateSchedule(ctx, &scheduler.UpdateScheduleInput{
+			Name:                       aws.String(data.ScheduleName.ValueString()),
+			GroupName:                  groupName,
+			ScheduleExpression:         aws.String(scheduleExpression),
+			ScheduleExpressionTimezone: aws.String("UTC"),
+			FlexibleTimeWindow:         flexibleTimeWindow,
+			ActionAfterCompletion:      schedulertypes.ActionAfterCompletionDelete,
+			Target:                     target,
+		})
+		if err == nil {
+			scheduleARN = output.ScheduleArn
+		} else {
+			// The schedule has already fired, so a new one is created for the update
+			var notFound *schedulertypes.ResourceNotFoundException
+			if !errors.As(err, &notFound) {
+				return err
+			}
+		}
+	}
+
+	if scheduleARN == nil {
+		output, err := meta.SchedulerClient.CreateSchedule(ctx, &scheduler.CreateScheduleInput{
+			Name:                       aws.String(data.ScheduleName.ValueString()),
+			GroupName:                  groupName,
+			ScheduleExpression:         aws.String(scheduleExpression),
+			ScheduleExpressionTimezone: aws.String("UTC"),
+			FlexibleTimeWindow:         flexibleTimeWindow,
+			ActionAfterCompletion:      schedulertypes.ActionAfterCompletionDelete,
+			Target:                     target,
+		})
+		if err != nil {
+			return err
+		}
+		scheduleARN = output.ScheduleArn
+	}
+
+	data.FireTime = types.StringValue(fireTime.Format(time.RFC3339))
+	data.ScheduleARN = types.StringPointerValue(scheduleARN)
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccEventPushSchedulerDelayedMessage_Simple(t *testing.T) {
+	config1 := `
+resource "eventpush_aws_scheduler_delayed_message" "test" {
+  target_arn   = "arn:aws:sqs:us-east-2:242306084486:test-queue"
+  role_arn     = "arn:aws:iam::242306084486:role/eventpush-scheduler"
+  message_body = "test message 1"
+  delay        = "720h"
+  envelope     = true
+}
+`
+
+	config2 := `
+resource "eventpush_aws_scheduler_delayed_message" "test" {
+  target_arn   = "arn:aws:sqs:us-east-2:242306084486:test-queue"
+  role_arn     = "arn:aws:iam::242306084486:role/eventpush-scheduler"
+  message_body = "test message 2"
+  delay        = "720h"
+  envelope     = true
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_aws_scheduler_delayed_message.test", "message_body", "test message 1"),
+					resource.TestCheckResourceAttrSet("eventpush_aws_scheduler_delayed_message.test", "schedule_arn"),
+					resource.TestCheckResourceAttrSet("eventpush_aws_scheduler_delayed_message.test", "fire_time"),
+				),
+			},
+			{
+				RefreshState: true,
+			},
+			{
+				Config: config2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_aws_scheduler_delayed_message.test", "message_body", "test message 2"),
+					resource.TestCheckResourceAttrSet("eventpush_aws_scheduler_delayed_message.test", "schedule_arn"),
+				),
+			},
+			{
+				Config:  config2,
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func TestAccEventPushSchedulerDelayedMessage_WithoutEnvelope(t *testing.T) {
+	config1 := `
+resource "eventpush_aws_scheduler_delayed_message" "test" {
+  target_arn   = "arn:aws:sqs:us-east-2:242306084486:test-queue"
+  role_arn     = "arn:aws:iam::242306084486:role/eventpush-scheduler"
+  message_body = "test message 1"
+  delay        = "720h"
+  envelope     = false
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config1,
+				ExpectError: regexp.MustCompile("Missing envelope"),
+			},
+		},
+	})
+}
@@ -0,0 +1,471 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2"
+	sesv2types "github.com/aws/aws-sdk-go-v2/service/sesv2/types"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+)
+
+var _ resource.Resource = &AWSSESSendEmailResource{}
+var _ resource.ResourceWithConfigure = &AWSSESSendEmailResource{}
+var _ resource.ResourceWithValidateConfig = &AWSSESSendEmailResource{}
+
+type AWSSESSendEmailResource struct {
+	AWSClient *AWSClient
+}
+
+type AWSSESSendEmailResourceModel struct {
+	BccAddresses         types.List                   `tfsdk:"bcc_addresses"`
+	CcAddresses          types.List                   `tfsdk:"cc_addresses"`
+	ConfigurationSetName types.String                 `tfsdk:"configuration_set_name"`
+	CreateOnly           types.Bool                   `tfsdk:"create_only"`
+	EventId              types.String                 `tfsdk:"event_id"`
+	FromEmailAddress     types.String                 `tfsdk:"from_email_address"`
+	HTMLBody             types.String                 `tfsdk:"html_body"`
+	KMSSignature         []KMSSignatureAttributeModel `tfsdk:"kms_signature"`
+	LifeCycleSubjects    types.Map                    `tfsdk:"lifecycle_subjects"`
+	MD5OfContent         types.String                 `tfsdk:"md5_of_content"`
+	MessageId            types.String                 `tfsdk:"message_id"`
+	ReplyToAddresses     types.List                   `tfsdk:"reply_to_addresses"`
+	Subject              types.String                 `tfsdk:"subject"`
+	TemplateData         types.String                 `tfsdk:"template_data"`
+	TemplateName         types.String                 `tfsdk:"template_name"`
+	TextBody             types.String                 `tfsdk:"text_body"`
+	ToAddresses          types.List                   `tfsdk:"to_addresses"`
+}
+
+func newAWSSESSendEmailResource() resource.Resource {
+	return &AWSSESSendEmailResource{}
+}
+
+func (r *AWSSESSendEmailResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerMeta := request.ProviderData.(Meta)
+
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("unable to load SDK config", err.Error())
+		return
+	}
+
+	if providerMeta.AWSConfigOptions.Region != "" {
+		cfg.Region = providerMeta.AWSConfigOptions.Region
+	}
+
+	sesClient := sesv2.NewFromConfig(cfg)
+	kmsClient := kms.NewFromConfig(cfg)
+
+	r.AWSClient = &AWSClient{
+		SESClient: sesClient,
+		KMSClient: kmsClient,
+		Region:    providerMeta.AWSConfigOptions.Region,
+	}
+}
+
+func (r *AWSSESSendEmailResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_aws_ses_send_email"
+}
+
+func (r *AWSSESSendEmailResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Send an email through AWS SES.",
+		Attributes: map[string]schema.Attribute{
+			"bcc_addresses": schema.ListAttribute{
+				Description: "The recipients to place on the BCC: line of the message.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"cc_addresses": schema.ListAttribute{
+				Description: "The recipients to place on the CC: line of the message.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"configuration_set_name": schema.StringAttribute{
+				Description: "The name of the configuration set to use when sending the email.",
+				Optional:    true,
+			},
+			"create_only": schema.BoolAttribute{
+				Description: "When enabled, forces resource to be replaced on update.",
+				Optional:    true,
+			},
+			"event_id": schema.StringAttribute{
+				Description: "Generated ID for resource tracking.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"from_email_address": schema.StringAttribute{
+				Description: "The email address to use as the From address for the email.",
+				Required:    true,
+			},
+			"html_body": schema.StringAttribute{
+				Description: "The HTML body of the message.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(replaceIfCreateOnlySet, "Forces replacement of resource.", "Forces replacement of resource."),
+				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("template_name")),
+				},
+			},
+			"lifecycle_subjects": schema.MapAttribute{
+				Description: "Subjects to use for specific lifecycle events, keyed by `create`, `update` or `delete`. Falls back to `subject`.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.OneOf("create", "update", "delete")),
+					mapvalidator.ConflictsWith(path.MatchRoot("template_name")),
+				},
+			},
+			"md5_of_content": schema.StringAttribute{
+				Description: "The MD5 of the message content.",
+				Computed:    true,
+			},
+			"message_id": schema.StringAttribute{
+				Description: "The ID of the last message sent.",
+				Computed:    true,
+			},
+			"reply_to_addresses": schema.ListAttribute{
+				Description: "The reply-to email addresses for the message.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"subject": schema.StringAttribute{
+				Description: "The subject line of the message.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(replaceIfCreateOnlySet, "Forces replacement of resource.", "Forces replacement of resource."),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("template_name")),
+				},
+			},
+			"template_data": schema.StringAttribute{
+				Description: "A valid JSON object containing replacement values for the template. The lifecycle event is added to the object under the `X-LifeCycle-Event` key.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(replaceIfCreateOnlySet, "Forces replacement of resource.", "Forces replacement of resource."),
+				},
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("template_name")),
+				},
+			},
+			"template_name": schema.StringAttribute{
+				Description: "The name of the stored template to send.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(replaceIfCreateOnlySet, "Forces replacement of resource.", "Forces replacement of resource."),
+				},
+			},
+			"text_body": schema.StringAttribute{
+				Description: "The text body of the message.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(replaceIfCreateOnlySet, "Forces replacement of resource.", "Forces replacement of resource."),
+				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("template_name")),
+				},
+			},
+			"to_addresses": schema.ListAttribute{
+				Description: "The recipients to place on the To: line of the message.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"kms_signature": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"kms_key_id": schema.StringAttribute{
+							Description: "The ID of the AWS KMS key.",
+							Required:    true,
+						},
+						"message_attribute": schema.StringAttribute{
+							Description: "Header name to add signature value.",
+							Optional:    true,
+						},
+						"algorithm": schema.StringAttribute{
+							Description: "The KMS signature algorithm.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOfCaseInsensitive(
+									[]string{
+										string(kmstypes.SigningAlgorithmSpecRsassaPkcs1V15Sha256),
+									}...,
+								),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.ConflictsWith(path.MatchRoot("template_name")),
+				},
+			},
+		},
+	}
+}
+
+func (r *AWSSESSendEmailResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data AWSSESSendEmailResourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.TextBody.IsNull() && data.HTMLBody.IsNull() && data.TemplateName.IsNull() {
+		response.Diagnostics.AddError(
+			"Missing email content",
+			"One of text_body, html_body or template_name must be set.",
+		)
+	}
+}
+
+func (r *AWSSESSendEmailResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data AWSSESSendEmailResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	err := sendEmail(ctx, r.AWSClient, &data, "create")
+	if err != nil {
+		response.Diagnostics.AddError("Error sending email with SES.", err.Error())
+		return
+	}
+
+	data.EventId = types.StringValue(uuid.New().String())
+	data.MD5OfContent = types.StringValue(createMD5OfEmailContent(&data))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *AWSSESSendEmailResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data AWSSESSendEmailResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *AWSSESSendEmailResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state AWSSESSendEmailResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	planContentMD5 := createMD5OfEmailContent(&plan)
+	stateContentMD5 := createMD5OfEmailContent(&state)
+
+	if planContentMD5 != stateContentMD5 {
+		err := sendEmail(ctx, r.AWSClient, &plan, "update")
+		if err != nil {
+			response.Diagnostics.AddError("Error sending email with SES.", err.Error())
+			return
+		}
+	} else {
+		plan.MessageId = state.MessageId
+	}
+	plan.MD5OfContent = types.StringValue(planContentMD5)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *AWSSESSendEmailResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data AWSSESSendEmailResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.CreateOnly.ValueBool() {
+		err := sendEmail(ctx, r.AWSClient, &data, "delete")
+		if err != nil {
+			response.Diagnostics.AddError("Error sending email with SES.", err.Error())
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func sendEmail(ctx context.Context, meta *AWSClient, data *AWSSESSendEmailResourceModel, lifeCycle string) error {
+	destination := &sesv2types.Destination{}
+
+	diags := data.ToAddresses.ElementsAs(ctx, &destination.ToAddresses, false)
+	if diags.HasError() {
+		return fmt.Errorf("unable to read to addresses")
+	}
+
+	if !data.CcAddresses.IsNull() {
+		diags = data.CcAddresses.ElementsAs(ctx, &destination.CcAddresses, false)
+		if diags.HasError() {
+			return fmt.Errorf("unable to read cc addresses")
+		}
+	}
+
+	if !data.BccAddresses.IsNull() {
+		diags = data.BccAddresses.ElementsAs(ctx, &destination.BccAddresses, false)
+		if diags.HasError() {
+			return fmt.Errorf("unable to read bcc addresses")
+		}
+	}
+
+	input := &sesv2.SendEmailInput{
+		FromEmailAddress: aws.String(data.FromEmailAddress.ValueString()),
+		Destination:      destination,
+		Content:          &sesv2types.EmailContent{},
+	}
+
+	if !data.ReplyToAddresses.IsNull() {
+		diags = data.ReplyToAddresses.ElementsAs(ctx, &input.ReplyToAddresses, false)
+		if diags.HasError() {
+			return fmt.Errorf("unable to read reply-to addresses")
+		}
+	}
+
+	if !data.ConfigurationSetName.IsNull() {
+		input.ConfigurationSetName = aws.String(data.ConfigurationSetName.ValueString())
+	}
+
+	headers := []sesv2types.MessageHeader{
+		{
+			Name:  aws.String("X-LifeCycle-Event"),
+			Value: aws.String(lifeCycle),
+		},
+	}
+
+	if !data.TemplateName.IsNull() {
+		templateData := map[string]any{}
+		if !data.TemplateData.IsNull() {
+			if err := json.Unmarshal([]byte(data.TemplateData.ValueString()), &templateData); err != nil {
+				return fmt.Errorf("template_data must be a valid JSON object: %w", err)
+			}
+			if templateData == nil {
+				return fmt.Errorf("template_data must be a valid JSON object")
+			}
+		}
+		templateData["X-LifeCycle-Event"] = lifeCycle
+
+		templateDataJSON, err := json.Marshal(templateData)
+		if err != nil {
+			return err
+		}
+
+		input.Content.Template = &sesv2types.Template{
+			TemplateName: aws.String(data.TemplateName.ValueString()),
+			TemplateData: aws.String(string(templateDataJSON)),
+			Headers:      headers,
+		}
+	} else {
+		subject := data.Subject.ValueString()
+		if !data.LifeCycleSubjects.IsNull() {
+			subjects := make(map[string]string)
+			diags = data.LifeCycleSubjects.ElementsAs(ctx, &subjects, false)
+			if diags.HasError() {
+				return fmt.Errorf("unable to read lifecycle subjects")
+			}
+			if lifeCycleSubject, ok := subjects[lifeCycle]; ok {
+				subject = lifeCycleSubject
+			}
+		}
+
+		body := &sesv2types.Body{}
+		if !data.TextBody.IsNull() {
+			body.Text = &sesv2types.Content{
+				Data:    aws.String(data.TextBody.ValueString()),
+				Charset: aws.String("UTF-8"),
+			}
+		}
+		if !data.HTMLBody.IsNull() {
+			body.Html = &sesv2types.Content{
+				Data:    aws.String(data.HTMLBody.ValueString()),
+				Charset: aws.String("UTF-8"),
+			}
+		}
+
+		if data.KMSSignature != nil {
+			// The text body is signed when present, otherwise the HTML body
+			signedBody := data.TextBody.ValueString()
+			if data.TextBody.IsNull() {
+				signedBody = data.HTMLBody.ValueString()
+			}
+
+			attrName, signature, err := signMessageWithKMSSignatureBlock(ctx, meta.KMSClient, data.KMSSignature[0], signedBody)
+			if err != nil {
+				return err
+			}
+
+			headers = append(headers, sesv2types.MessageHeader{
+				Name:  aws.String(attrName),
+				Value: aws.String(signature),
+			})
+		}
+
+		input.Content.Simple = &sesv2types.Message{
+			Subject: &sesv2types.Content{
+				Data:    aws.String(subject),
+				Charset: aws.String("UTF-8"),
+			},
+			Body:    body,
+			Headers: headers,
+		}
+	}
+
+	output, err := meta.SESClient.SendEmail(ctx, input)
+	if err != nil {
+		return err
+	}
+
+	data.MessageId = types.StringPointerValue(output.MessageId)
+
+	return nil
+}
+
+func createMD5OfEmailContent(data *AWSSESSendEmailResourceModel) string {
+	return createMD5OfMessageBody(strings.Join([]string{
+		data.Subject.ValueString(),
+		data.LifeCycleSubjects.String(),
+		data.HTMLBody.ValueString(),
+		data.TextBody.ValueString(),
+		data.TemplateName.ValueString(),
+		data.TemplateData.ValueString(),
+	}, "\x00"))
+}
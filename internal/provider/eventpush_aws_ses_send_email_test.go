@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccEventPushSESSendEmail_Simple(t *testing.T) {
+	config1 := `
+resource "eventpush_aws_ses_send_email" "test" {
+  from_email_address = "eventpush@example.com"
+  to_addresses       = ["success@simulator.amazonses.com"]
+  subject            = "Stack updated"
+  text_body          = "test message 1"
+
+  lifecycle_subjects = {
+    create = "Stack created"
+    delete = "Stack destroyed"
+  }
+
+  kms_signature {
+    kms_key_id = "arn:aws:kms:us-east-2:242306084486:key/9834cc70-67b2-446b-b921-34feb2c33406"
+  }
+}
+`
+
+	config2 := `
+resource "eventpush_aws_ses_send_email" "test" {
+  from_email_address = "eventpush@example.com"
+  to_addresses       = ["success@simulator.amazonses.com"]
+  subject            = "Stack updated"
+  text_body          = "test message 2"
+
+  lifecycle_subjects = {
+    create = "Stack created"
+    delete = "Stack destroyed"
+  }
+
+  kms_signature {
+    kms_key_id = "arn:aws:kms:us-east-2:242306084486:key/9834cc70-67b2-446b-b921-34feb2c33406"
+  }
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("eventpush_aws_ses_send_email.test", "message_id"),
+				),
+			},
+			{
+				RefreshState: true,
+			},
+			{
+				Config: config2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("eventpush_aws_ses_send_email.test", "message_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEventPushSESSendEmail_Template(t *testing.T) {
+	config1 := `
+resource "eventpush_aws_ses_send_email" "test" {
+  from_email_address = "eventpush@example.com"
+  to_addresses       = ["success@simulator.amazonses.com"]
+  template_name      = "TestTemplate"
+  template_data      = jsonencode({ stack = "test" })
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_aws_ses_send_email.test", "template_name", "TestTemplate"),
+					resource.TestCheckResourceAttrSet("eventpush_aws_ses_send_email.test", "message_id"),
+				),
+			},
+			{
+				Config:  config1,
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func TestAccEventPushSESSendEmail_MissingContent(t *testing.T) {
+	config1 := `
+resource "eventpush_aws_ses_send_email" "test" {
+  from_email_address = "eventpush@example.com"
+  to_addresses       = ["success@simulator.amazonses.com"]
+  subject            = "Stack updated"
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config1,
+				ExpectError: regexp.MustCompile("Missing email content"),
+			},
+		},
+	})
+}
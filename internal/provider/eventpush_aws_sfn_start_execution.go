@@ -0,0 +1,329 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	sfntypes "github.com/aws/aws-sdk-go-v2/service/sfn/types"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"time"
+)
+
+var _ resource.Resource = &AWSSFNStartExecutionResource{}
+var _ resource.ResourceWithConfigure = &AWSSFNStartExecutionResource{}
+
+const sfnExecutionPollInterval = 5 * time.Second
+
+const sfnExecutionNameMaxLength = 80
+
+type AWSSFNStartExecutionResource struct {
+	AWSClient *AWSClient
+}
+
+type AWSSFNStartExecutionResourceModel struct {
+	CreateOnly        types.Bool   `tfsdk:"create_only"`
+	EventId           types.String `tfsdk:"event_id"`
+	ExecutionARN      types.String `tfsdk:"execution_arn"`
+	ExecutionName     types.String `tfsdk:"execution_name"`
+	Input             types.String `tfsdk:"input"`
+	MD5OfInput        types.String `tfsdk:"md5_of_input"`
+	Output            types.String `tfsdk:"output"`
+	SendCount         types.Int64  `tfsdk:"send_count"`
+	StateMachineARN   types.String `tfsdk:"state_machine_arn"`
+	Status            types.String `tfsdk:"status"`
+	WaitForCompletion types.Bool   `tfsdk:"wait_for_completion"`
+}
+
+func newAWSSFNStartExecutionResource() resource.Resource {
+	return &AWSSFNStartExecutionResource{}
+}
+
+func (r *AWSSFNStartExecutionResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerMeta := request.ProviderData.(Meta)
+
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("unable to load SDK config", err.Error())
+		return
+	}
+
+	if providerMeta.AWSConfigOptions.Region != "" {
+		cfg.Region = providerMeta.AWSConfigOptions.Region
+	}
+
+	sfnClient := sfn.NewFromConfig(cfg)
+
+	r.AWSClient = &AWSClient{
+		SFNClient: sfnClient,
+		Region:    providerMeta.AWSConfigOptions.Region,
+	}
+}
+
+func (r *AWSSFNStartExecutionResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_aws_sfn_start_execution"
+}
+
+func (r *AWSSFNStartExecutionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Start an execution of an AWS Step Functions state machine.",
+		Attributes: map[string]schema.Attribute{
+			"create_only": schema.BoolAttribute{
+				Description: "When enabled, forces resource to be replaced on update.",
+				Optional:    true,
+			},
+			"event_id": schema.StringAttribute{
+				Description: "Generated ID for resource tracking.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"execution_arn": schema.StringAttribute{
+				Description: "The ARN of the last execution started.",
+				Computed:    true,
+			},
+			"execution_name": schema.StringAttribute{
+				Description: "The name of the last execution started, derived from the event ID, the lifecycle event, the send count and the MD5 of the input.",
+				Computed:    true,
+			},
+			"input": schema.StringAttribute{
+				Description: "A valid JSON object used as the execution input. The lifecycle event is added to the object under the `X-LifeCycle-Event` key.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(replaceIfCreateOnlySet, "Forces replacement of resource.", "Forces replacement of resource."),
+				},
+			},
+			"md5_of_input": schema.StringAttribute{
+				Description: "The MD5 of the execution input.",
+				Computed:    true,
+			},
+			"output": schema.StringAttribute{
+				Description: "The output of the last execution when `wait_for_completion` is enabled.",
+				Computed:    true,
+			},
+			"send_count": schema.Int64Attribute{
+				Description: "The number of executions started. Used in the execution name so each send starts a new execution.",
+				Computed:    true,
+			},
+			"state_machine_arn": schema.StringAttribute{
+				Description: "The ARN of the state machine to execute.",
+				Required:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the last execution.",
+				Computed:    true,
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Description: "When enabled, waits for the execution to finish and fails if it does not succeed. Only supported for standard workflows.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *AWSSFNStartExecutionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data AWSSFNStartExecutionResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Event ID is set before starting the execution since it is used in the execution name
+	data.EventId = types.StringValue(uuid.New().String())
+	data.SendCount = types.Int64Value(0)
+
+	err := startExecution(ctx, r.AWSClient, &data, "create")
+	if err != nil {
+		response.Diagnostics.AddError("Error starting Step Functions execution.", err.Error())
+		return
+	}
+
+	data.MD5OfInput = types.StringValue(createMD5OfMessageBody(data.Input.ValueString()))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *AWSSFNStartExecutionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data AWSSFNStartExecutionResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *AWSSFNStartExecutionResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state AWSSFNStartExecutionResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	planInputMD5 := createMD5OfMessageBody(plan.Input.ValueString())
+	stateInputMD5 := createMD5OfMessageBody(state.Input.ValueString())
+
+	plan.SendCount = state.SendCount
+	if planInputMD5 != stateInputMD5 {
+		err := startExecution(ctx, r.AWSClient, &plan, "update")
+		if err != nil {
+			response.Diagnostics.AddError("Error starting Step Functions execution.", err.Error())
+			return
+		}
+	} else {
+		plan.ExecutionARN = state.ExecutionARN
+		plan.ExecutionName = state.ExecutionName
+		plan.Output = state.Output
+		plan.Status = state.Status
+	}
+	plan.MD5OfInput = types.StringValue(planInputMD5)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *AWSSFNStartExecutionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data AWSSFNStartExecutionResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.CreateOnly.ValueBool() {
+		err := startExecution(ctx, r.AWSClient, &data, "delete")
+		if err != nil {
+			response.Diagnostics.AddError("Error starting Step Functions execution.", err.Error())
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func startExecution(ctx context.Context, meta *AWSClient, data *AWSSFNStartExecutionResourceModel, lifeCycle string) error {
+	var input map[string]any
+	if err := json.Unmarshal([]byte(data.Input.ValueString()), &input); err != nil {
+		return fmt.Errorf("input must be a valid JSON object: %w", err)
+	}
+	if input == nil {
+		return fmt.Errorf("input must be a valid JSON object")
+	}
+	input["X-LifeCycle-Event"] = lifeCycle
+
+	inputJSON, err := json.Marshal(input)
+	if err != nil {
+		return err
+	}
+
+	data.Output = types.StringNull()
+
+	var executionName string
+	sendCount := data.SendCount.ValueInt64()
+	for {
+		sendCount++
+
+		// Execution names are limited to 80 characters, so the end of the input hash is trimmed when needed
+		executionName = lifecycleSendId(data.EventId.ValueString(), lifeCycle, sendCount, data.Input.ValueString())
+		executionName = executionName[:min(len(executionName), sfnExecutionNameMaxLength)]
+
+		executionArn, err := sfnExecutionARN(data.StateMachineARN.ValueString(), executionName)
+		if err != nil {
+			return err
+		}
+
+		output, err := meta.SFNClient.StartExecution(ctx, &sfn.StartExecutionInput{
+			StateMachineArn: aws.String(data.StateMachineARN.ValueString()),
+			Name:            aws.String(executionName),
+			Input:           aws.String(string(inputJSON)),
+		})
+		if err == nil {
+			data.ExecutionARN = types.StringPointerValue(output.ExecutionArn)
+			data.Status = types.StringValue(string(sfntypes.ExecutionStatusRunning))
+			break
+		}
+
+		var alreadyExists *sfntypes.ExecutionAlreadyExists
+		if !errors.As(err, &alreadyExists) {
+			return err
+		}
+
+		execution, err := meta.SFNClient.DescribeExecution(ctx, &sfn.DescribeExecutionInput{
+			ExecutionArn: aws.String(executionArn),
+		})
+		if err != nil {
+			return fmt.Errorf("execution %s already exists and could not be described: %w", executionName, err)
+		}
+
+		// The execution was started by an earlier attempt of the same apply. It is reused unless it ended
+		// without succeeding, so a retry after a failed execution starts a new one under the next send count.
+		if execution.Status == sfntypes.ExecutionStatusRunning || execution.Status == sfntypes.ExecutionStatusSucceeded {
+			data.ExecutionARN = types.StringValue(executionArn)
+			data.Status = types.StringValue(string(execution.Status))
+			break
+		}
+	}
+
+	data.ExecutionName = types.StringValue(executionName)
+	data.SendCount = types.Int64Value(sendCount)
+
+	if !data.WaitForCompletion.ValueBool() {
+		return nil
+	}
+
+	for {
+		execution, err := meta.SFNClient.DescribeExecution(ctx, &sfn.DescribeExecutionInput{
+			ExecutionArn: aws.String(data.ExecutionARN.ValueString()),
+		})
+		if err != nil {
+			return err
+		}
+
+		data.Status = types.StringValue(string(execution.Status))
+
+		switch execution.Status {
+		case sfntypes.ExecutionStatusSucceeded:
+			data.Output = types.StringPointerValue(execution.Output)
+			return nil
+		case sfntypes.ExecutionStatusFailed, sfntypes.ExecutionStatusTimedOut, sfntypes.ExecutionStatusAborted:
+			return fmt.Errorf("execution %s ended with status %s: %s: %s", executionName, execution.Status, aws.ToString(execution.Error), aws.ToString(execution.Cause))
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(sfnExecutionPollInterval):
+		}
+	}
+}
+
+// sfnExecutionARN returns the ARN of the named execution of a state machine.
+func sfnExecutionARN(stateMachineArn string, executionName string) (string, error) {
+	parts := strings.Split(stateMachineArn, ":")
+	if len(parts) < 7 || parts[5] != "stateMachine" {
+		return "", fmt.Errorf("state machine ARN %q is not in the form arn:{partition}:states:{region}:{account}:stateMachine:{name}", stateMachineArn)
+	}
+
+	return fmt.Sprintf("%s:execution:%s:%s", strings.Join(parts[:5], ":"), parts[6], executionName), nil
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccEventPushSFNStartExecution_Simple(t *testing.T) {
+	config1 := `
+resource "eventpush_aws_sfn_start_execution" "test" {
+  state_machine_arn = "arn:aws:states:us-east-2:242306084486:stateMachine:TestStateMachine"
+  input             = jsonencode({ message = "test message 1" })
+}
+`
+
+	config2 := `
+resource "eventpush_aws_sfn_start_execution" "test" {
+  state_machine_arn = "arn:aws:states:us-east-2:242306084486:stateMachine:TestStateMachine"
+  input             = jsonencode({ message = "test message 2" })
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_aws_sfn_start_execution.test", "status", "RUNNING"),
+					resource.TestCheckResourceAttrSet("eventpush_aws_sfn_start_execution.test", "execution_arn"),
+					resource.TestCheckResourceAttr("eventpush_aws_sfn_start_execution.test", "send_count", "1"),
+				),
+			},
+			{
+				RefreshState: true,
+			},
+			{
+				Config: config2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_aws_sfn_start_execution.test", "status", "RUNNING"),
+					resource.TestCheckResourceAttrSet("eventpush_aws_sfn_start_execution.test", "execution_arn"),
+					resource.TestCheckResourceAttr("eventpush_aws_sfn_start_execution.test", "send_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEventPushSFNStartExecution_WaitForCompletion(t *testing.T) {
+	config1 := `
+resource "eventpush_aws_sfn_start_execution" "test" {
+  state_machine_arn   = "arn:aws:states:us-east-2:242306084486:stateMachine:TestStateMachine"
+  input               = jsonencode({ message = "test message 1" })
+  wait_for_completion = true
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_aws_sfn_start_execution.test", "status", "SUCCEEDED"),
+					resource.TestCheckResourceAttrSet("eventpush_aws_sfn_start_execution.test", "output"),
+				),
+			},
+			{
+				Config:  config1,
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func TestSFNExecutionARN(t *testing.T) {
+	cases := []struct {
+		stateMachineArn string
+		expected        string
+		expectError     bool
+	}{
+		{
+			stateMachineArn: "arn:aws:states:us-east-2:242306084486:stateMachine:TestStateMachine",
+			expected:        "arn:aws:states:us-east-2:242306084486:execution:TestStateMachine:name",
+		},
+		{
+			stateMachineArn: "arn:aws-us-gov:states:us-gov-west-1:242306084486:stateMachine:TestStateMachine:1",
+			expected:        "arn:aws-us-gov:states:us-gov-west-1:242306084486:execution:TestStateMachine:name",
+		},
+		{
+			stateMachineArn: "arn:aws:states:us-east-2:242306084486:activity:TestActivity",
+			expectError:     true,
+		},
+	}
+
+	for _, c := range cases {
+		actual, err := sfnExecutionARN(c.stateMachineArn, "name")
+		if c.expectError {
+			if err == nil {
+				t.Errorf("sfnExecutionARN(%q) expected an error", c.stateMachineArn)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("sfnExecutionARN(%q) returned error: %s", c.stateMachineArn, err)
+			continue
+		}
+		if actual != c.expected {
+			t.Errorf("sfnExecutionARN(%q) = %q, expected %q", c.stateMachineArn, actual, c.expected)
+		}
+	}
+}
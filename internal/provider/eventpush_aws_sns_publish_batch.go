@@ -0,0 +1,453 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	snstypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &AWSSNSPublishBatchResource{}
+var _ resource.ResourceWithConfigure = &AWSSNSPublishBatchResource{}
+var _ resource.ResourceWithValidateConfig = &AWSSNSPublishBatchResource{}
+
+type AWSSNSPublishBatchResource struct {
+	AWSClient *AWSClient
+}
+
+type AWSSNSPublishBatchResourceModel struct {
+	CreateOnly   types.Bool                   `tfsdk:"create_only"`
+	Entry        []SNSBatchEntryModel         `tfsdk:"entry"`
+	EventId      types.String                 `tfsdk:"event_id"`
+	KMSSignature []KMSSignatureAttributeModel `tfsdk:"kms_signature"`
+	Results      types.List                   `tfsdk:"results"`
+	TopicARN     types.String                 `tfsdk:"topic_arn"`
+}
+
+type SNSBatchEntryModel struct {
+	Id                     types.String `tfsdk:"id"`
+	MessageAttributes      types.Map    `tfsdk:"message_attributes"`
+	MessageBody            types.String `tfsdk:"message_body"`
+	MessageDeduplicationId types.String `tfsdk:"message_deduplication_id"`
+	MessageGroupId         types.String `tfsdk:"message_group_id"`
+	Subject                types.String `tfsdk:"subject"`
+}
+
+func (entry SNSBatchEntryModel) entryId() string {
+	return entry.Id.ValueString()
+}
+
+func (entry SNSBatchEntryModel) entryMessageBody() string {
+	return entry.MessageBody.ValueString()
+}
+
+func newAWSSNSPublishBatchResource() resource.Resource {
+	return &AWSSNSPublishBatchResource{}
+}
+
+func (r *AWSSNSPublishBatchResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerMeta := request.ProviderData.(Meta)
+
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("unable to load SDK config", err.Error())
+		return
+	}
+
+	if providerMeta.AWSConfigOptions.Region != "" {
+		cfg.Region = providerMeta.AWSConfigOptions.Region
+	}
+
+	snsClient := sns.NewFromConfig(cfg)
+	kmsClient := kms.NewFromConfig(cfg)
+
+	r.AWSClient = &AWSClient{
+		SNSClient: snsClient,
+		KMSClient: kmsClient,
+		Region:    providerMeta.AWSConfigOptions.Region,
+	}
+}
+
+func (r *AWSSNSPublishBatchResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_aws_sns_publish_batch"
+}
+
+func (r *AWSSNSPublishBatchResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Publish a batch of messages to an AWS SNS Topic.",
+		Attributes: map[string]schema.Attribute{
+			"create_only": schema.BoolAttribute{
+				Description: "When enabled, forces resource to be replaced on update.",
+				Optional:    true,
+			},
+			"event_id": schema.StringAttribute{
+				Description: "Generated ID for resource tracking.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"results": schema.ListNestedAttribute{
+				Description: "The result of the last publish of each entry.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: batchEntryResultSchemaAttributes(),
+				},
+			},
+			"topic_arn": schema.StringAttribute{
+				Description: "The topic you want to publish to.",
+				Required:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"entry": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "An identifier for the entry, unique within the batch. Used to match entries between updates.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(batchEntryIdRegexp, "must contain only alphanumeric characters, hyphens and underscores, up to 80 characters"),
+							},
+						},
+						"message_attributes": schema.MapAttribute{
+							Description: "String message attributes to send with the message.",
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"message_body": schema.StringAttribute{
+							Description: "The message to send.",
+							Required:    true,
+						},
+						"message_deduplication_id": schema.StringAttribute{
+							Description: "The token used for deduplication of messages published to FIFO topics. Defaults to a SHA-256 hash of the event ID, the entry ID, the lifecycle event, the send count of the entry and the message body.",
+							Optional:    true,
+						},
+						"message_group_id": schema.StringAttribute{
+							Description: "The tag that specifies that a message belongs to a specific message group. Required for FIFO topics.",
+							Optional:    true,
+						},
+						"subject": schema.StringAttribute{
+							Description: "The subject line used when the message is delivered to email endpoints.",
+							Optional:    true,
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplaceIf(replaceListIfCreateOnlySet, "Forces replacement of resource.", "Forces replacement of resource."),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"kms_signature": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"kms_key_id": schema.StringAttribute{
+							Description: "The ID of the AWS KMS key.",
+							Required:    true,
+						},
+						"message_attribute": schema.StringAttribute{
+							Description: "Message attribute name to add signature value.",
+							Optional:    true,
+						},
+						"algorithm": schema.StringAttribute{
+							Description: "The KMS signature algorithm.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOfCaseInsensitive(
+									[]string{
+										string(kmstypes.SigningAlgorithmSpecRsassaPkcs1V15Sha256),
+									}...,
+								),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *AWSSNSPublishBatchResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data AWSSNSPublishBatchResourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ids := make(map[string]bool)
+	for i, entry := range data.Entry {
+		if entry.Id.IsUnknown() {
+			continue
+		}
+
+		if ids[entry.Id.ValueString()] {
+			response.Diagnostics.AddAttributeError(
+				path.Root("entry").AtListIndex(i).AtName("id"),
+				"Duplicate entry ID",
+				fmt.Sprintf("The entry ID %q is used more than once.", entry.Id.ValueString()),
+			)
+		}
+		ids[entry.Id.ValueString()] = true
+	}
+
+	if data.TopicARN.IsUnknown() || !isFIFOTopic(data.TopicARN.ValueString()) {
+		return
+	}
+
+	for i, entry := range data.Entry {
+		if entry.MessageGroupId.IsNull() {
+			response.Diagnostics.AddAttributeError(
+				path.Root("entry").AtListIndex(i).AtName("message_group_id"),
+				"Missing attribute for FIFO topic",
+				"A message group ID is required when publishing to a FIFO topic.",
+			)
+		}
+	}
+}
+
+func (r *AWSSNSPublishBatchResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data AWSSNSPublishBatchResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.EventId = types.StringValue(uuid.New().String())
+
+	results, err := publishBatch(ctx, r.AWSClient, &data, data.Entry, "create", nil)
+	if err != nil {
+		response.Diagnostics.AddError("Error publishing message batch to SNS topic.", err.Error())
+
+		// Nothing was published, so there is no resource to keep in state
+		if len(results) == 0 {
+			return
+		}
+	}
+
+	var diags diag.Diagnostics
+	data.Results, diags = batchEntryResultsValueFromSlice(ctx, snsBatchEntryIds(data.Entry), results)
+	response.Diagnostics.Append(diags...)
+	addBatchEntryFailureWarnings(&response.Diagnostics, results, "create")
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *AWSSNSPublishBatchResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data AWSSNSPublishBatchResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *AWSSNSPublishBatchResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state AWSSNSPublishBatchResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	results, diags := readBatchEntryResults(ctx, state.Results)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Entry = updateBatchEntries(plan.Entry, state.Entry, results, plan.CreateOnly.ValueBool(), "Error publishing message batch to SNS topic.", &response.Diagnostics,
+		func(entries []SNSBatchEntryModel, lifeCycle string) ([]BatchEntryResultModel, error) {
+			if lifeCycle == "delete" {
+				return publishBatch(ctx, r.AWSClient, &state, entries, lifeCycle, results)
+			}
+			return publishBatch(ctx, r.AWSClient, &plan, entries, lifeCycle, results)
+		})
+
+	plan.Results, diags = batchEntryResultsValue(ctx, snsBatchEntryIds(plan.Entry), results)
+	response.Diagnostics.Append(diags...)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *AWSSNSPublishBatchResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data AWSSNSPublishBatchResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.CreateOnly.ValueBool() {
+		previous, diags := readBatchEntryResults(ctx, data.Results)
+		response.Diagnostics.Append(diags...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		results, err := publishBatch(ctx, r.AWSClient, &data, data.Entry, "delete", previous)
+		if err != nil {
+			response.Diagnostics.AddError("Error publishing message batch to SNS topic.", err.Error())
+			return
+		}
+		addBatchEntryFailureWarnings(&response.Diagnostics, results, "delete")
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// publishBatch publishes the entries in chunks. On error it returns the results of the chunks already published along with it.
+func publishBatch(ctx context.Context, meta *AWSClient, data *AWSSNSPublishBatchResourceModel, entries []SNSBatchEntryModel, lifeCycle string, previous map[string]BatchEntryResultModel) ([]BatchEntryResultModel, error) {
+	results := make([]BatchEntryResultModel, 0, len(entries))
+
+	for start := 0; start < len(entries); start += batchMaxEntries {
+		end := min(start+batchMaxEntries, len(entries))
+
+		input := &sns.PublishBatchInput{
+			TopicArn: aws.String(data.TopicARN.ValueString()),
+		}
+
+		md5s := make(map[string]string)
+		for _, entry := range entries[start:end] {
+			batchEntry, err := createSNSBatchRequestEntry(ctx, meta, data, entry, lifeCycle, batchEntrySendCount(previous, entry.Id.ValueString())+1)
+			if err != nil {
+				return results, err
+			}
+			input.PublishBatchRequestEntries = append(input.PublishBatchRequestEntries, batchEntry)
+			md5s[entry.Id.ValueString()] = createMD5OfMessageBody(entry.MessageBody.ValueString())
+		}
+
+		output, err := meta.SNSClient.PublishBatch(ctx, input)
+		if err != nil {
+			return results, err
+		}
+
+		for _, successful := range output.Successful {
+			results = append(results, BatchEntryResultModel{
+				Id:               types.StringPointerValue(successful.Id),
+				MD5OfMessageBody: types.StringValue(md5s[aws.ToString(successful.Id)]),
+				MessageId:        types.StringPointerValue(successful.MessageId),
+				SendCount:        types.Int64Value(batchEntrySendCount(previous, aws.ToString(successful.Id)) + 1),
+				SequenceNumber:   types.StringPointerValue(successful.SequenceNumber),
+				Status:           types.StringValue("success"),
+			})
+		}
+
+		for _, failed := range output.Failed {
+			results = append(results, BatchEntryResultModel{
+				ErrorCode:        types.StringPointerValue(failed.Code),
+				ErrorMessage:     types.StringPointerValue(failed.Message),
+				Id:               types.StringPointerValue(failed.Id),
+				MD5OfMessageBody: types.StringValue(md5s[aws.ToString(failed.Id)]),
+				SendCount:        types.Int64Value(batchEntrySendCount(previous, aws.ToString(failed.Id))),
+				Status:           types.StringValue("failed"),
+			})
+		}
+	}
+
+	return results, nil
+}
+
+func createSNSBatchRequestEntry(ctx context.Context, meta *AWSClient, data *AWSSNSPublishBatchResourceModel, entry SNSBatchEntryModel, lifeCycle string, sendCount int64) (snstypes.PublishBatchRequestEntry, error) {
+	messageAttributes := make(map[string]snstypes.MessageAttributeValue)
+	batchEntry := snstypes.PublishBatchRequestEntry{
+		Id:      aws.String(entry.Id.ValueString()),
+		Message: aws.String(entry.MessageBody.ValueString()),
+	}
+
+	if !entry.Subject.IsNull() {
+		batchEntry.Subject = aws.String(entry.Subject.ValueString())
+	}
+
+	if !entry.MessageGroupId.IsNull() {
+		batchEntry.MessageGroupId = aws.String(entry.MessageGroupId.ValueString())
+	}
+
+	if !entry.MessageDeduplicationId.IsNull() {
+		batchEntry.MessageDeduplicationId = aws.String(entry.MessageDeduplicationId.ValueString())
+	} else if isFIFOTopic(data.TopicARN.ValueString()) {
+		// Derive the deduplication ID so a retried apply doesn't produce duplicate messages
+		batchEntry.MessageDeduplicationId = aws.String(batchEntryDeduplicationId(data.EventId.ValueString(), entry.Id.ValueString(), lifeCycle, sendCount, entry.MessageBody.ValueString()))
+	}
+
+	if !entry.MessageAttributes.IsNull() {
+		attributes := make(map[string]string)
+		diags := entry.MessageAttributes.ElementsAs(ctx, &attributes, false)
+		if diags.HasError() {
+			return batchEntry, fmt.Errorf("unable to read message attributes")
+		}
+
+		for name, value := range attributes {
+			messageAttributes[name] = snstypes.MessageAttributeValue{
+				DataType:    aws.String("String"),
+				StringValue: aws.String(value),
+			}
+		}
+	}
+
+	if data.KMSSignature != nil {
+		attrName, signature, err := signMessageWithKMSSignatureBlock(ctx, meta.KMSClient, data.KMSSignature[0], entry.MessageBody.ValueString())
+		if err != nil {
+			return batchEntry, err
+		}
+
+		messageAttributes[attrName] = snstypes.MessageAttributeValue{
+			DataType:    aws.String("String"),
+			StringValue: aws.String(signature),
+		}
+	}
+
+	messageAttributes["X-LifeCycle-Event"] = snstypes.MessageAttributeValue{
+		DataType:    aws.String("String"),
+		StringValue: aws.String(lifeCycle),
+	}
+
+	batchEntry.MessageAttributes = messageAttributes
+
+	return batchEntry, nil
+}
+
+func snsBatchEntryIds(entries []SNSBatchEntryModel) []string {
+	ids := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		ids = append(ids, entry.Id.ValueString())
+	}
+	return ids
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccEventPushSNSPublishBatch_Simple(t *testing.T) {
+	config1 := `
+resource "eventpush_aws_sns_publish_batch" "test" {
+  topic_arn = "arn:aws:sns:us-east-2:242306084486:TestTopic"
+
+  entry {
+    id           = "first"
+    message_body = "test message 1"
+  }
+
+  entry {
+    id           = "second"
+    message_body = "test message 2"
+  }
+}
+`
+
+	config2 := `
+resource "eventpush_aws_sns_publish_batch" "test" {
+  topic_arn = "arn:aws:sns:us-east-2:242306084486:TestTopic"
+
+  entry {
+    id           = "first"
+    message_body = "test message 1"
+  }
+
+  entry {
+    id           = "second"
+    message_body = "test message 3"
+  }
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_aws_sns_publish_batch.test", "topic_arn", "arn:aws:sns:us-east-2:242306084486:TestTopic"),
+					resource.TestCheckResourceAttr("eventpush_aws_sns_publish_batch.test", "results.#", "2"),
+					resource.TestCheckResourceAttr("eventpush_aws_sns_publish_batch.test", "results.0.status", "success"),
+					resource.TestCheckResourceAttrSet("eventpush_aws_sns_publish_batch.test", "results.0.message_id"),
+				),
+			},
+			{
+				RefreshState: true,
+			},
+			{
+				Config: config2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_aws_sns_publish_batch.test", "topic_arn", "arn:aws:sns:us-east-2:242306084486:TestTopic"),
+					resource.TestCheckResourceAttr("eventpush_aws_sns_publish_batch.test", "results.#", "2"),
+					resource.TestCheckResourceAttr("eventpush_aws_sns_publish_batch.test", "results.1.md5_of_message_body", "6ab756a597bb7fd5e62a310fe107f572"),
+					resource.TestCheckResourceAttrSet("eventpush_aws_sns_publish_batch.test", "results.1.message_id"),
+				),
+			},
+			{
+				Config:  config2,
+				Destroy: true,
+			},
+		},
+	})
+}
@@ -2,6 +2,8 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/kms"
@@ -10,30 +12,62 @@ import (
 	snstypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+	"strings"
 )
 
 var _ resource.Resource = &AWSSNSPublishMessageResource{}
 var _ resource.ResourceWithConfigure = &AWSSNSPublishMessageResource{}
+var _ resource.ResourceWithValidateConfig = &AWSSNSPublishMessageResource{}
+
+var snsMessageStructureProtocols = []string{
+	"application",
+	"email",
+	"email-json",
+	"firehose",
+	"http",
+	"https",
+	"lambda",
+	"sms",
+	"sqs",
+}
 
 type AWSSNSPublishMessageResource struct {
 	AWSClient *AWSClient
 }
 
 type AWSSNSPublishMessageResourceModel struct {
-	CreateOnly       types.Bool                   `tfsdk:"create_only"`
-	EventId          types.String                 `tfsdk:"event_id"`
-	KMSSignature     []KMSSignatureAttributeModel `tfsdk:"kms_signature"`
-	MD5OfMessageBody types.String                 `tfsdk:"md5_of_message_body"`
-	MessageBody      types.String                 `tfsdk:"message_body"`
-	TopicARN         types.String                 `tfsdk:"topic_arn"`
+	CreateOnly             types.Bool                   `tfsdk:"create_only"`
+	EventId                types.String                 `tfsdk:"event_id"`
+	KMSSignature           []KMSSignatureAttributeModel `tfsdk:"kms_signature"`
+	MD5OfMessageBody       types.String                 `tfsdk:"md5_of_message_body"`
+	MessageBody            types.String                 `tfsdk:"message_body"`
+	MessageDeduplicationId types.String                 `tfsdk:"message_deduplication_id"`
+	MessageGroupId         types.String                 `tfsdk:"message_group_id"`
+	MessageStructure       types.String                 `tfsdk:"message_structure"`
+	PhoneNumber            types.String                 `tfsdk:"phone_number"`
+	ProtocolMessages       types.Map                    `tfsdk:"protocol_messages"`
+	SendCount              types.Int64                  `tfsdk:"send_count"`
+	SMS                    []SMSAttributeModel          `tfsdk:"sms"`
+	Subject                types.String                 `tfsdk:"subject"`
+	TargetARN              types.String                 `tfsdk:"target_arn"`
+	TopicARN               types.String                 `tfsdk:"topic_arn"`
+}
+
+type SMSAttributeModel struct {
+	MaxPrice types.Float64 `tfsdk:"max_price"`
+	SenderID types.String  `tfsdk:"sender_id"`
+	SMSType  types.String  `tfsdk:"sms_type"`
 }
 
 func newAWSSNSPublishMessageResource() resource.Resource {
@@ -73,7 +107,7 @@ func (r *AWSSNSPublishMessageResource) Metadata(ctx context.Context, request res
 
 func (r *AWSSNSPublishMessageResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	response.Schema = schema.Schema{
-		MarkdownDescription: "Send a message to an AWS SNS Topic.",
+		MarkdownDescription: "Send a message to an AWS SNS Topic, mobile platform endpoint or phone number.",
 		Attributes: map[string]schema.Attribute{
 			"create_only": schema.BoolAttribute{
 				Description: "When enabled, forces resource to be replaced on update.",
@@ -97,9 +131,54 @@ func (r *AWSSNSPublishMessageResource) Schema(ctx context.Context, request resou
 					stringplanmodifier.RequiresReplaceIf(replaceIfCreateOnlySet, "Forces replacement of resource.", "Forces replacement of resource."),
 				},
 			},
+			"message_deduplication_id": schema.StringAttribute{
+				Description: "The token used for deduplication of published messages on FIFO topics. Defaults to a value derived from the event ID, the lifecycle event, the send count and the published message.",
+				Optional:    true,
+			},
+			"message_group_id": schema.StringAttribute{
+				Description: "The tag that specifies that a message belongs to a specific message group. Required for FIFO topics.",
+				Optional:    true,
+			},
+			"message_structure": schema.StringAttribute{
+				Description: "Set to `json` to send a different message for each protocol. The message body is used as the `default` message when `protocol_messages` is set, otherwise it must be a JSON object containing a `default` key.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("json"),
+				},
+			},
+			"phone_number": schema.StringAttribute{
+				Description: "The phone number, in E.164 format, to which the message is sent as an SMS.",
+				Optional:    true,
+			},
+			"protocol_messages": schema.MapAttribute{
+				Description: "Messages to send for specific protocols, keyed by protocol. Requires `message_structure` to be `json`.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.OneOf(snsMessageStructureProtocols...)),
+				},
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplaceIf(replaceMapIfCreateOnlySet, "Forces replacement of resource.", "Forces replacement of resource."),
+				},
+			},
+			"send_count": schema.Int64Attribute{
+				Description: "The number of messages published. Used in the derived deduplication ID so each publish is delivered once.",
+				Computed:    true,
+			},
+			"subject": schema.StringAttribute{
+				Description: "The subject line used when the message is delivered to email endpoints.",
+				Optional:    true,
+			},
+			"target_arn": schema.StringAttribute{
+				Description: "The ARN of the mobile platform endpoint you want to publish to.",
+				Optional:    true,
+			},
 			"topic_arn": schema.StringAttribute{
 				Description: "The topic you want to publish to.",
-				Required:    true,
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("target_arn"), path.MatchRoot("phone_number")),
+				},
 			},
 		},
 		Blocks: map[string]schema.Block{
@@ -131,10 +210,78 @@ func (r *AWSSNSPublishMessageResource) Schema(ctx context.Context, request resou
 					listvalidator.SizeAtMost(1),
 				},
 			},
+			"sms": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_price": schema.Float64Attribute{
+							Description: "The maximum amount in USD that you are willing to spend to send the SMS message.",
+							Optional:    true,
+						},
+						"sender_id": schema.StringAttribute{
+							Description: "A custom ID that contains 3-11 alphanumeric characters, including at least one letter and no spaces.",
+							Optional:    true,
+						},
+						"sms_type": schema.StringAttribute{
+							Description: "The type of message being sent, either `Promotional` or `Transactional`.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("Promotional", "Transactional"),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.AlsoRequires(path.MatchRoot("phone_number")),
+				},
+			},
 		},
 	}
 }
 
+func (r *AWSSNSPublishMessageResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data AWSSNSPublishMessageResourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ProtocolMessages.IsNull() && !data.MessageStructure.IsUnknown() && data.MessageStructure.ValueString() != "json" {
+		response.Diagnostics.AddAttributeError(
+			path.Root("protocol_messages"),
+			"Missing message structure",
+			"Protocol messages can only be sent when message_structure is set to json.",
+		)
+	}
+
+	if data.MessageStructure.ValueString() == "json" && data.ProtocolMessages.IsNull() && !data.MessageBody.IsUnknown() {
+		var message map[string]any
+		if err := json.Unmarshal([]byte(data.MessageBody.ValueString()), &message); err != nil {
+			response.Diagnostics.AddAttributeError(
+				path.Root("message_body"),
+				"Invalid message structure",
+				fmt.Sprintf("The message body must be a valid JSON object when message_structure is set to json: %s", err),
+			)
+		} else if _, ok := message["default"].(string); !ok {
+			response.Diagnostics.AddAttributeError(
+				path.Root("message_body"),
+				"Invalid message structure",
+				"The message body must contain a default key with a string value when message_structure is set to json.",
+			)
+		}
+	}
+
+	if !data.TopicARN.IsUnknown() && isFIFOTopic(data.TopicARN.ValueString()) && data.MessageGroupId.IsNull() {
+		response.Diagnostics.AddAttributeError(
+			path.Root("message_group_id"),
+			"Missing attribute for FIFO topic",
+			"A message group ID is required when publishing to a FIFO topic.",
+		)
+	}
+}
+
 func (r *AWSSNSPublishMessageResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	var data AWSSNSPublishMessageResourceModel
 
@@ -144,13 +291,15 @@ func (r *AWSSNSPublishMessageResource) Create(ctx context.Context, request resou
 		return
 	}
 
+	data.EventId = types.StringValue(uuid.New().String())
+	data.SendCount = types.Int64Value(0)
+
 	err := publishMessage(ctx, r.AWSClient, &data, "create")
 	if err != nil {
 		response.Diagnostics.AddError("Error sending message to SNS topic.", err.Error())
 		return
 	}
 
-	data.EventId = types.StringValue(uuid.New().String())
 	data.MD5OfMessageBody = types.StringValue(createMD5OfMessageBody(data.MessageBody.ValueString()))
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
@@ -181,7 +330,8 @@ func (r *AWSSNSPublishMessageResource) Update(ctx context.Context, request resou
 	planMessageBodyMD5 := createMD5OfMessageBody(plan.MessageBody.ValueString())
 	stateMessageBodyMD5 := createMD5OfMessageBody(state.MessageBody.ValueString())
 
-	if planMessageBodyMD5 != stateMessageBodyMD5 {
+	plan.SendCount = state.SendCount
+	if planMessageBodyMD5 != stateMessageBodyMD5 || !plan.ProtocolMessages.Equal(state.ProtocolMessages) {
 		err := publishMessage(ctx, r.AWSClient, &plan, "update")
 		if err != nil {
 			response.Diagnostics.AddError("Error sending message to SNS topic.", err.Error())
@@ -217,8 +367,56 @@ func (r *AWSSNSPublishMessageResource) Delete(ctx context.Context, request resou
 func publishMessage(ctx context.Context, meta *AWSClient, data *AWSSNSPublishMessageResourceModel, lifeCycle string) error {
 	messageAttributes := make(map[string]snstypes.MessageAttributeValue)
 	input := &sns.PublishInput{
-		TopicArn: aws.String(data.TopicARN.ValueString()),
-		Message:  aws.String(data.MessageBody.ValueString()),
+		Message: aws.String(data.MessageBody.ValueString()),
+	}
+
+	if !data.TopicARN.IsNull() {
+		input.TopicArn = aws.String(data.TopicARN.ValueString())
+	}
+
+	if !data.TargetARN.IsNull() {
+		input.TargetArn = aws.String(data.TargetARN.ValueString())
+	}
+
+	if !data.PhoneNumber.IsNull() {
+		input.PhoneNumber = aws.String(data.PhoneNumber.ValueString())
+	}
+
+	if !data.Subject.IsNull() {
+		input.Subject = aws.String(data.Subject.ValueString())
+	}
+
+	if !data.MessageStructure.IsNull() {
+		input.MessageStructure = aws.String(data.MessageStructure.ValueString())
+	}
+
+	if !data.ProtocolMessages.IsNull() {
+		messages := make(map[string]string)
+		diags := data.ProtocolMessages.ElementsAs(ctx, &messages, false)
+		if diags.HasError() {
+			return fmt.Errorf("unable to read protocol messages")
+		}
+		messages["default"] = data.MessageBody.ValueString()
+
+		message, err := json.Marshal(messages)
+		if err != nil {
+			return err
+		}
+		input.Message = aws.String(string(message))
+	}
+
+	if !data.MessageGroupId.IsNull() {
+		input.MessageGroupId = aws.String(data.MessageGroupId.ValueString())
+	}
+
+	sendCount := data.SendCount.ValueInt64() + 1
+
+	if !data.MessageDeduplicationId.IsNull() {
+		input.MessageDeduplicationId = aws.String(data.MessageDeduplicationId.ValueString())
+	} else if isFIFOTopic(data.TopicARN.ValueString()) {
+		// Derive the deduplication ID so a retried apply doesn't produce duplicate messages. The hash covers
+		// the published message, which includes the protocol messages, and the message structure.
+		input.MessageDeduplicationId = aws.String(lifecycleSendId(data.EventId.ValueString(), lifeCycle, sendCount, data.MessageStructure.ValueString()+"\n"+aws.ToString(input.Message)))
 	}
 
 	if data.KMSSignature != nil {
@@ -245,6 +443,31 @@ func publishMessage(ctx context.Context, meta *AWSClient, data *AWSSNSPublishMes
 		}
 	}
 
+	if data.SMS != nil {
+		smsBlock := data.SMS[0]
+
+		if !smsBlock.SenderID.IsNull() {
+			messageAttributes["AWS.SNS.SMS.SenderID"] = snstypes.MessageAttributeValue{
+				DataType:    aws.String("String"),
+				StringValue: aws.String(smsBlock.SenderID.ValueString()),
+			}
+		}
+
+		if !smsBlock.SMSType.IsNull() {
+			messageAttributes["AWS.SNS.SMS.SMSType"] = snstypes.MessageAttributeValue{
+				DataType:    aws.String("String"),
+				StringValue: aws.String(smsBlock.SMSType.ValueString()),
+			}
+		}
+
+		if !smsBlock.MaxPrice.IsNull() {
+			messageAttributes["AWS.SNS.SMS.MaxPrice"] = snstypes.MessageAttributeValue{
+				DataType:    aws.String("Number"),
+				StringValue: aws.String(strconv.FormatFloat(smsBlock.MaxPrice.ValueFloat64(), 'f', -1, 64)),
+			}
+		}
+	}
+
 	messageAttributes["X-LifeCycle-Event"] = snstypes.MessageAttributeValue{
 		DataType:    aws.String("String"),
 		StringValue: aws.String(lifeCycle),
@@ -252,7 +475,13 @@ func publishMessage(ctx context.Context, meta *AWSClient, data *AWSSNSPublishMes
 
 	input.MessageAttributes = messageAttributes
 	_, err := meta.SNSClient.Publish(ctx, input)
-	return err
+	if err != nil {
+		return err
+	}
+
+	data.SendCount = types.Int64Value(sendCount)
+
+	return nil
 }
 
 func replaceIfCreateOnlySet(ctx context.Context, request planmodifier.StringRequest, response *stringplanmodifier.RequiresReplaceIfFuncResponse) {
@@ -270,3 +499,23 @@ func replaceIfCreateOnlySet(ctx context.Context, request planmodifier.StringRequ
 		}
 	}
 }
+
+func replaceMapIfCreateOnlySet(ctx context.Context, request planmodifier.MapRequest, response *mapplanmodifier.RequiresReplaceIfFuncResponse) {
+	var createOnly types.Bool
+
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("create_only"), &createOnly)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !createOnly.IsNull() {
+		if createOnly.ValueBool() {
+			response.RequiresReplace = true
+		}
+	}
+}
+
+func isFIFOTopic(topicArn string) bool {
+	return strings.HasSuffix(topicArn, ".fifo")
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
 	"testing"
 )
 
@@ -49,3 +50,127 @@ resource "eventpush_aws_sns_publish_message" "test" {
 		},
 	})
 }
+
+func TestAccEventPushSNSPublishMessage_MessageStructure(t *testing.T) {
+	config1 := `
+resource "eventpush_aws_sns_publish_message" "test" {
+  message_body      = "test message 1"
+  message_structure = "json"
+  subject           = "Test Subject"
+  topic_arn         = "arn:aws:sns:us-east-2:242306084486:TestTopic"
+
+  protocol_messages = {
+    email = "test email message 1"
+    sqs   = jsonencode({ message = "test message 1" })
+  }
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_aws_sns_publish_message.test", "message_structure", "json"),
+					resource.TestCheckResourceAttr("eventpush_aws_sns_publish_message.test", "protocol_messages.%", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEventPushSNSPublishMessage_MessageStructureMissingDefault(t *testing.T) {
+	config1 := `
+resource "eventpush_aws_sns_publish_message" "test" {
+  message_body      = jsonencode({ email = "test message 1" })
+  message_structure = "json"
+  topic_arn         = "arn:aws:sns:us-east-2:242306084486:TestTopic"
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config1,
+				ExpectError: regexp.MustCompile("Invalid message structure"),
+			},
+		},
+	})
+}
+
+func TestAccEventPushSNSPublishMessage_FIFO(t *testing.T) {
+	config1 := `
+resource "eventpush_aws_sns_publish_message" "test" {
+  message_body     = "test message 1"
+  message_group_id = "test"
+  topic_arn        = "arn:aws:sns:us-east-2:242306084486:TestTopic.fifo"
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_aws_sns_publish_message.test", "message_group_id", "test"),
+					resource.TestCheckResourceAttr("eventpush_aws_sns_publish_message.test", "send_count", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEventPushSNSPublishMessage_PhoneNumber(t *testing.T) {
+	config1 := `
+resource "eventpush_aws_sns_publish_message" "test" {
+  message_body = "test message 1"
+  phone_number = "+15555550100"
+
+  sms {
+    sender_id = "EventPush"
+    sms_type  = "Transactional"
+    max_price = 0.5
+  }
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_aws_sns_publish_message.test", "phone_number", "+15555550100"),
+					resource.TestCheckResourceAttr("eventpush_aws_sns_publish_message.test", "sms.0.sms_type", "Transactional"),
+				),
+			},
+			{
+				Config:  config1,
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func TestAccEventPushSNSPublishMessage_MultipleDestinations(t *testing.T) {
+	config1 := `
+resource "eventpush_aws_sns_publish_message" "test" {
+  message_body = "test message 1"
+  topic_arn    = "arn:aws:sns:us-east-2:242306084486:TestTopic"
+  phone_number = "+15555550100"
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config1,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
@@ -13,29 +13,36 @@ import (
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
 )
 
 var _ resource.Resource = &AWSSQSSendMessageResource{}
 var _ resource.ResourceWithConfigure = &AWSSQSSendMessageResource{}
+var _ resource.ResourceWithValidateConfig = &AWSSQSSendMessageResource{}
 
 type AWSSQSSendMessageResource struct {
 	AWSClient *AWSClient
 }
 
 type AWSSQSSendMessageResourceModel struct {
-	CreateOnly       types.Bool                   `tfsdk:"create_only"`
-	DelaySeconds     types.Int32                  `tfsdk:"delay_seconds"`
-	EventId          types.String                 `tfsdk:"event_id"`
-	KMSSignature     []KMSSignatureAttributeModel `tfsdk:"kms_signature"`
-	MD5OfMessageBody types.String                 `tfsdk:"md5_of_message_body"`
-	MessageBody      types.String                 `tfsdk:"message_body"`
-	QueueUrl         types.String                 `tfsdk:"queue_url"`
+	CreateOnly             types.Bool                   `tfsdk:"create_only"`
+	DelaySeconds           types.Int32                  `tfsdk:"delay_seconds"`
+	EventId                types.String                 `tfsdk:"event_id"`
+	KMSSignature           []KMSSignatureAttributeModel `tfsdk:"kms_signature"`
+	MD5OfMessageBody       types.String                 `tfsdk:"md5_of_message_body"`
+	MessageBody            types.String                 `tfsdk:"message_body"`
+	MessageDeduplicationId types.String                 `tfsdk:"message_deduplication_id"`
+	MessageGroupId         types.String                 `tfsdk:"message_group_id"`
+	QueueUrl               types.String                 `tfsdk:"queue_url"`
+	SendCount              types.Int64                  `tfsdk:"send_count"`
+	SequenceNumber         types.String                 `tfsdk:"sequence_number"`
 }
 
 type KMSSignatureAttributeModel struct {
@@ -109,10 +116,26 @@ func (r *AWSSQSSendMessageResource) Schema(ctx context.Context, request resource
 					stringplanmodifier.RequiresReplaceIf(replaceIfCreateOnlySet, "Forces replacement of resource.", "Forces replacement of resource."),
 				},
 			},
+			"message_deduplication_id": schema.StringAttribute{
+				Description: "The token used for deduplication of sent messages on FIFO queues. Defaults to a value derived from the event ID, the lifecycle event, the send count and the message body.",
+				Optional:    true,
+			},
+			"message_group_id": schema.StringAttribute{
+				Description: "The tag that specifies that a message belongs to a specific message group. Required for FIFO queues.",
+				Optional:    true,
+			},
 			"queue_url": schema.StringAttribute{
 				Description: "The URL of the Amazon SQS queue which a message is sent.",
 				Required:    true,
 			},
+			"send_count": schema.Int64Attribute{
+				Description: "The number of messages sent. Used in the derived deduplication ID so each send is delivered once.",
+				Computed:    true,
+			},
+			"sequence_number": schema.StringAttribute{
+				Description: "The sequence number assigned to the last message sent to a FIFO queue.",
+				Computed:    true,
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"kms_signature": schema.ListNestedBlock{
@@ -147,6 +170,36 @@ func (r *AWSSQSSendMessageResource) Schema(ctx context.Context, request resource
 	}
 }
 
+func (r *AWSSQSSendMessageResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data AWSSQSSendMessageResourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.QueueUrl.IsUnknown() || data.QueueUrl.IsNull() || !isFIFOQueue(data.QueueUrl.ValueString()) {
+		return
+	}
+
+	if !data.DelaySeconds.IsNull() {
+		response.Diagnostics.AddAttributeError(
+			path.Root("delay_seconds"),
+			"Invalid attribute for FIFO queue",
+			"FIFO queues don't support per-message delays, only per-queue delays.",
+		)
+	}
+
+	if data.MessageGroupId.IsNull() {
+		response.Diagnostics.AddAttributeError(
+			path.Root("message_group_id"),
+			"Missing attribute for FIFO queue",
+			"A message group ID is required when sending to a FIFO queue.",
+		)
+	}
+}
+
 func (r *AWSSQSSendMessageResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	var data AWSSQSSendMessageResourceModel
 
@@ -156,15 +209,16 @@ func (r *AWSSQSSendMessageResource) Create(ctx context.Context, request resource
 		return
 	}
 
+	// Set event ID only in creation lifecycle, before sending since it is used for deduplication
+	data.EventId = types.StringValue(uuid.New().String())
+	data.SendCount = types.Int64Value(0)
+
 	err := sendMessage(ctx, r.AWSClient, &data, "create")
 	if err != nil {
 		response.Diagnostics.AddError("Error sending message to SQS queue.", err.Error())
 		return
 	}
 
-	// Set event ID only in creation lifecycle
-	data.EventId = types.StringValue(uuid.New().String())
-
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
@@ -193,6 +247,7 @@ func (r *AWSSQSSendMessageResource) Update(ctx context.Context, request resource
 	planMessageBodyMD5 := createMD5OfMessageBody(plan.MessageBody.ValueString())
 	stateMessageBodyMD5 := createMD5OfMessageBody(state.MessageBody.ValueString())
 
+	plan.SendCount = state.SendCount
 	if planMessageBodyMD5 != stateMessageBodyMD5 {
 		err := sendMessage(ctx, r.AWSClient, &plan, "update")
 		if err != nil {
@@ -201,6 +256,7 @@ func (r *AWSSQSSendMessageResource) Update(ctx context.Context, request resource
 		}
 	} else {
 		plan.MD5OfMessageBody = types.StringValue(planMessageBodyMD5)
+		plan.SequenceNumber = state.SequenceNumber
 	}
 
 	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
@@ -237,6 +293,19 @@ func sendMessage(ctx context.Context, meta *AWSClient, data *AWSSQSSendMessageRe
 		input.DelaySeconds = data.DelaySeconds.ValueInt32()
 	}
 
+	if !data.MessageGroupId.IsNull() {
+		input.MessageGroupId = aws.String(data.MessageGroupId.ValueString())
+	}
+
+	sendCount := data.SendCount.ValueInt64() + 1
+
+	if !data.MessageDeduplicationId.IsNull() {
+		input.MessageDeduplicationId = aws.String(data.MessageDeduplicationId.ValueString())
+	} else if isFIFOQueue(data.QueueUrl.ValueString()) {
+		// Derive the deduplication ID so a retried apply doesn't produce duplicate messages
+		input.MessageDeduplicationId = aws.String(lifecycleSendId(data.EventId.ValueString(), lifeCycle, sendCount, data.MessageBody.ValueString()))
+	}
+
 	if data.KMSSignature != nil {
 		kmsBlock := data.KMSSignature[0]
 
@@ -274,6 +343,8 @@ func sendMessage(ctx context.Context, meta *AWSClient, data *AWSSQSSendMessageRe
 	}
 
 	data.MD5OfMessageBody = types.StringPointerValue(output.MD5OfMessageBody)
+	data.SendCount = types.Int64Value(sendCount)
+	data.SequenceNumber = types.StringPointerValue(output.SequenceNumber)
 
 	return nil
 }
@@ -283,3 +354,7 @@ func createMD5OfMessageBody(input string) string {
 	hashString := hex.EncodeToString(hash[:])
 	return hashString
 }
+
+func isFIFOQueue(queueUrl string) bool {
+	return strings.HasSuffix(queueUrl, ".fifo")
+}
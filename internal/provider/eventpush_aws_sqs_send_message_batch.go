@@ -0,0 +1,695 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var _ resource.Resource = &AWSSQSSendMessageBatchResource{}
+var _ resource.ResourceWithConfigure = &AWSSQSSendMessageBatchResource{}
+var _ resource.ResourceWithValidateConfig = &AWSSQSSendMessageBatchResource{}
+
+// SQS and SNS both accept at most 10 entries per batch request
+const batchMaxEntries = 10
+
+var batchEntryIdRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]{1,80}$`)
+
+var batchEntryResultAttrTypes = map[string]attr.Type{
+	"error_code":          types.StringType,
+	"error_message":       types.StringType,
+	"id":                  types.StringType,
+	"md5_of_message_body": types.StringType,
+	"message_id":          types.StringType,
+	"send_count":          types.Int64Type,
+	"sequence_number":     types.StringType,
+	"status":              types.StringType,
+}
+
+type AWSSQSSendMessageBatchResource struct {
+	AWSClient *AWSClient
+}
+
+type AWSSQSSendMessageBatchResourceModel struct {
+	CreateOnly   types.Bool                   `tfsdk:"create_only"`
+	Entry        []SQSBatchEntryModel         `tfsdk:"entry"`
+	EventId      types.String                 `tfsdk:"event_id"`
+	KMSSignature []KMSSignatureAttributeModel `tfsdk:"kms_signature"`
+	QueueUrl     types.String                 `tfsdk:"queue_url"`
+	Results      types.List                   `tfsdk:"results"`
+}
+
+type SQSBatchEntryModel struct {
+	DelaySeconds           types.Int32  `tfsdk:"delay_seconds"`
+	Id                     types.String `tfsdk:"id"`
+	MessageAttributes      types.Map    `tfsdk:"message_attributes"`
+	MessageBody            types.String `tfsdk:"message_body"`
+	MessageDeduplicationId types.String `tfsdk:"message_deduplication_id"`
+	MessageGroupId         types.String `tfsdk:"message_group_id"`
+}
+
+type BatchEntryResultModel struct {
+	ErrorCode        types.String `tfsdk:"error_code"`
+	ErrorMessage     types.String `tfsdk:"error_message"`
+	Id               types.String `tfsdk:"id"`
+	MD5OfMessageBody types.String `tfsdk:"md5_of_message_body"`
+	MessageId        types.String `tfsdk:"message_id"`
+	SendCount        types.Int64  `tfsdk:"send_count"`
+	SequenceNumber   types.String `tfsdk:"sequence_number"`
+	Status           types.String `tfsdk:"status"`
+}
+
+// batchEntry is implemented by the entry models of the batch resources, so updates can be diffed the same way.
+type batchEntry interface {
+	entryId() string
+	entryMessageBody() string
+}
+
+func (entry SQSBatchEntryModel) entryId() string {
+	return entry.Id.ValueString()
+}
+
+func (entry SQSBatchEntryModel) entryMessageBody() string {
+	return entry.MessageBody.ValueString()
+}
+
+func newAWSSQSSendMessageBatchResource() resource.Resource {
+	return &AWSSQSSendMessageBatchResource{}
+}
+
+func (r *AWSSQSSendMessageBatchResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerMeta := request.ProviderData.(Meta)
+
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("unable to load SDK config", err.Error())
+		return
+	}
+
+	if providerMeta.AWSConfigOptions.Region != "" {
+		cfg.Region = providerMeta.AWSConfigOptions.Region
+	}
+
+	sqsClient := sqs.NewFromConfig(cfg)
+	kmsClient := kms.NewFromConfig(cfg)
+
+	r.AWSClient = &AWSClient{
+		SQSClient: sqsClient,
+		KMSClient: kmsClient,
+		Region:    providerMeta.AWSConfigOptions.Region,
+	}
+}
+
+func (r *AWSSQSSendMessageBatchResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_aws_sqs_send_message_batch"
+}
+
+func (r *AWSSQSSendMessageBatchResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Send a batch of messages to an AWS SQS Queue.",
+		Attributes: map[string]schema.Attribute{
+			"create_only": schema.BoolAttribute{
+				Description: "When enabled, forces resource to be replaced on update.",
+				Optional:    true,
+			},
+			"event_id": schema.StringAttribute{
+				Description: "Generated ID for resource tracking.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"queue_url": schema.StringAttribute{
+				Description: "The URL of the Amazon SQS queue which the messages are sent.",
+				Required:    true,
+			},
+			"results": schema.ListNestedAttribute{
+				Description: "The result of the last send of each entry.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: batchEntryResultSchemaAttributes(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"entry": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"delay_seconds": schema.Int32Attribute{
+							Description: "The length of time, in seconds, for which to delay the message.",
+							Optional:    true,
+						},
+						"id": schema.StringAttribute{
+							Description: "An identifier for the entry, unique within the batch. Used to match entries between updates.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(batchEntryIdRegexp, "must contain only alphanumeric characters, hyphens and underscores, up to 80 characters"),
+							},
+						},
+						"message_attributes": schema.MapAttribute{
+							Description: "String message attributes to send with the message.",
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"message_body": schema.StringAttribute{
+							Description: "The message to send.",
+							Required:    true,
+						},
+						"message_deduplication_id": schema.StringAttribute{
+							Description: "The token used for deduplication of sent messages on FIFO queues. Defaults to a SHA-256 hash of the event ID, the entry ID, the lifecycle event, the send count of the entry and the message body.",
+							Optional:    true,
+						},
+						"message_group_id": schema.StringAttribute{
+							Description: "The tag that specifies that a message belongs to a specific message group. Required for FIFO queues.",
+							Optional:    true,
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplaceIf(replaceListIfCreateOnlySet, "Forces replacement of resource.", "Forces replacement of resource."),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"kms_signature": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"kms_key_id": schema.StringAttribute{
+							Description: "The ID of the AWS KMS key.",
+							Required:    true,
+						},
+						"message_attribute": schema.StringAttribute{
+							Description: "Message attribute name to add signature value.",
+							Optional:    true,
+						},
+						"algorithm": schema.StringAttribute{
+							Description: "The KMS signature algorithm.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOfCaseInsensitive(
+									[]string{
+										string(kmstypes.SigningAlgorithmSpecRsassaPkcs1V15Sha256),
+									}...,
+								),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *AWSSQSSendMessageBatchResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data AWSSQSSendMessageBatchResourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ids := make(map[string]bool)
+	for i, entry := range data.Entry {
+		if entry.Id.IsUnknown() {
+			continue
+		}
+
+		if ids[entry.Id.ValueString()] {
+			response.Diagnostics.AddAttributeError(
+				path.Root("entry").AtListIndex(i).AtName("id"),
+				"Duplicate entry ID",
+				fmt.Sprintf("The entry ID %q is used more than once.", entry.Id.ValueString()),
+			)
+		}
+		ids[entry.Id.ValueString()] = true
+	}
+
+	if data.QueueUrl.IsUnknown() || data.QueueUrl.IsNull() || !isFIFOQueue(data.QueueUrl.ValueString()) {
+		return
+	}
+
+	for i, entry := range data.Entry {
+		if !entry.DelaySeconds.IsNull() {
+			response.Diagnostics.AddAttributeError(
+				path.Root("entry").AtListIndex(i).AtName("delay_seconds"),
+				"Invalid attribute for FIFO queue",
+				"FIFO queues don't support per-message delays, only per-queue delays.",
+			)
+		}
+
+		if entry.MessageGroupId.IsNull() {
+			response.Diagnostics.AddAttributeError(
+				path.Root("entry").AtListIndex(i).AtName("message_group_id"),
+				"Missing attribute for FIFO queue",
+				"A message group ID is required when sending to a FIFO queue.",
+			)
+		}
+	}
+}
+
+func (r *AWSSQSSendMessageBatchResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data AWSSQSSendMessageBatchResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Set event ID only in creation lifecycle, before sending since it is used for deduplication
+	data.EventId = types.StringValue(uuid.New().String())
+
+	results, err := sendMessageBatch(ctx, r.AWSClient, &data, data.Entry, "create", nil)
+	if err != nil {
+		response.Diagnostics.AddError("Error sending message batch to SQS queue.", err.Error())
+
+		// Nothing was sent, so there is no resource to keep in state
+		if len(results) == 0 {
+			return
+		}
+	}
+
+	var diags diag.Diagnostics
+	data.Results, diags = batchEntryResultsValueFromSlice(ctx, sqsBatchEntryIds(data.Entry), results)
+	response.Diagnostics.Append(diags...)
+	addBatchEntryFailureWarnings(&response.Diagnostics, results, "create")
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *AWSSQSSendMessageBatchResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data AWSSQSSendMessageBatchResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *AWSSQSSendMessageBatchResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state AWSSQSSendMessageBatchResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	results, diags := readBatchEntryResults(ctx, state.Results)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Entry = updateBatchEntries(plan.Entry, state.Entry, results, plan.CreateOnly.ValueBool(), "Error sending message batch to SQS queue.", &response.Diagnostics,
+		func(entries []SQSBatchEntryModel, lifeCycle string) ([]BatchEntryResultModel, error) {
+			if lifeCycle == "delete" {
+				return sendMessageBatch(ctx, r.AWSClient, &state, entries, lifeCycle, results)
+			}
+			return sendMessageBatch(ctx, r.AWSClient, &plan, entries, lifeCycle, results)
+		})
+
+	plan.Results, diags = batchEntryResultsValue(ctx, sqsBatchEntryIds(plan.Entry), results)
+	response.Diagnostics.Append(diags...)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *AWSSQSSendMessageBatchResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data AWSSQSSendMessageBatchResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.CreateOnly.ValueBool() {
+		previous, diags := readBatchEntryResults(ctx, data.Results)
+		response.Diagnostics.Append(diags...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		results, err := sendMessageBatch(ctx, r.AWSClient, &data, data.Entry, "delete", previous)
+		if err != nil {
+			response.Diagnostics.AddError("Error sending message batch to SQS queue.", err.Error())
+			return
+		}
+		addBatchEntryFailureWarnings(&response.Diagnostics, results, "delete")
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// sendMessageBatch sends the entries in chunks. On error it returns the results of the chunks already sent along with it.
+func sendMessageBatch(ctx context.Context, meta *AWSClient, data *AWSSQSSendMessageBatchResourceModel, entries []SQSBatchEntryModel, lifeCycle string, previous map[string]BatchEntryResultModel) ([]BatchEntryResultModel, error) {
+	results := make([]BatchEntryResultModel, 0, len(entries))
+
+	for start := 0; start < len(entries); start += batchMaxEntries {
+		end := min(start+batchMaxEntries, len(entries))
+
+		input := &sqs.SendMessageBatchInput{
+			QueueUrl: aws.String(data.QueueUrl.ValueString()),
+		}
+
+		md5s := make(map[string]string)
+		for _, entry := range entries[start:end] {
+			batchEntry, err := createSQSBatchRequestEntry(ctx, meta, data, entry, lifeCycle, batchEntrySendCount(previous, entry.Id.ValueString())+1)
+			if err != nil {
+				return results, err
+			}
+			input.Entries = append(input.Entries, batchEntry)
+			md5s[entry.Id.ValueString()] = createMD5OfMessageBody(entry.MessageBody.ValueString())
+		}
+
+		output, err := meta.SQSClient.SendMessageBatch(ctx, input)
+		if err != nil {
+			return results, err
+		}
+
+		for _, successful := range output.Successful {
+			results = append(results, BatchEntryResultModel{
+				Id:               types.StringPointerValue(successful.Id),
+				MD5OfMessageBody: types.StringValue(md5s[aws.ToString(successful.Id)]),
+				MessageId:        types.StringPointerValue(successful.MessageId),
+				SendCount:        types.Int64Value(batchEntrySendCount(previous, aws.ToString(successful.Id)) + 1),
+				SequenceNumber:   types.StringPointerValue(successful.SequenceNumber),
+				Status:           types.StringValue("success"),
+			})
+		}
+
+		for _, failed := range output.Failed {
+			results = append(results, BatchEntryResultModel{
+				ErrorCode:        types.StringPointerValue(failed.Code),
+				ErrorMessage:     types.StringPointerValue(failed.Message),
+				Id:               types.StringPointerValue(failed.Id),
+				MD5OfMessageBody: types.StringValue(md5s[aws.ToString(failed.Id)]),
+				SendCount:        types.Int64Value(batchEntrySendCount(previous, aws.ToString(failed.Id))),
+				Status:           types.StringValue("failed"),
+			})
+		}
+	}
+
+	return results, nil
+}
+
+func createSQSBatchRequestEntry(ctx context.Context, meta *AWSClient, data *AWSSQSSendMessageBatchResourceModel, entry SQSBatchEntryModel, lifeCycle string, sendCount int64) (sqstypes.SendMessageBatchRequestEntry, error) {
+	messageAttributes := make(map[string]sqstypes.MessageAttributeValue)
+	batchEntry := sqstypes.SendMessageBatchRequestEntry{
+		Id:          aws.String(entry.Id.ValueString()),
+		MessageBody: aws.String(entry.MessageBody.ValueString()),
+	}
+
+	if !entry.DelaySeconds.IsNull() {
+		batchEntry.DelaySeconds = entry.DelaySeconds.ValueInt32()
+	}
+
+	if !entry.MessageGroupId.IsNull() {
+		batchEntry.MessageGroupId = aws.String(entry.MessageGroupId.ValueString())
+	}
+
+	if !entry.MessageDeduplicationId.IsNull() {
+		batchEntry.MessageDeduplicationId = aws.String(entry.MessageDeduplicationId.ValueString())
+	} else if isFIFOQueue(data.QueueUrl.ValueString()) {
+		// Derive the deduplication ID so a retried apply doesn't produce duplicate messages
+		batchEntry.MessageDeduplicationId = aws.String(batchEntryDeduplicationId(data.EventId.ValueString(), entry.Id.ValueString(), lifeCycle, sendCount, entry.MessageBody.ValueString()))
+	}
+
+	if !entry.MessageAttributes.IsNull() {
+		attributes := make(map[string]string)
+		diags := entry.MessageAttributes.ElementsAs(ctx, &attributes, false)
+		if diags.HasError() {
+			return batchEntry, fmt.Errorf("unable to read message attributes")
+		}
+
+		for name, value := range attributes {
+			messageAttributes[name] = sqstypes.MessageAttributeValue{
+				DataType:    aws.String("String"),
+				StringValue: aws.String(value),
+			}
+		}
+	}
+
+	if data.KMSSignature != nil {
+		attrName, signature, err := signMessageWithKMSSignatureBlock(ctx, meta.KMSClient, data.KMSSignature[0], entry.MessageBody.ValueString())
+		if err != nil {
+			return batchEntry, err
+		}
+
+		messageAttributes[attrName] = sqstypes.MessageAttributeValue{
+			DataType:    aws.String("String"),
+			StringValue: aws.String(signature),
+		}
+	}
+
+	messageAttributes["X-LifeCycle-Event"] = sqstypes.MessageAttributeValue{
+		DataType:    aws.String("String"),
+		StringValue: aws.String(lifeCycle),
+	}
+
+	batchEntry.MessageAttributes = messageAttributes
+
+	return batchEntry, nil
+}
+
+func sqsBatchEntryIds(entries []SQSBatchEntryModel) []string {
+	ids := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		ids = append(ids, entry.Id.ValueString())
+	}
+	return ids
+}
+
+func batchEntryResultSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"error_code": schema.StringAttribute{
+			Description: "The error code when the entry failed.",
+			Computed:    true,
+		},
+		"error_message": schema.StringAttribute{
+			Description: "The error message when the entry failed.",
+			Computed:    true,
+		},
+		"id": schema.StringAttribute{
+			Description: "The ID of the entry.",
+			Computed:    true,
+		},
+		"md5_of_message_body": schema.StringAttribute{
+			Description: "The MD5 of the message body.",
+			Computed:    true,
+		},
+		"message_id": schema.StringAttribute{
+			Description: "The ID of the message.",
+			Computed:    true,
+		},
+		"send_count": schema.Int64Attribute{
+			Description: "The number of messages sent for the entry. Used in the derived deduplication ID so each send is delivered once.",
+			Computed:    true,
+		},
+		"sequence_number": schema.StringAttribute{
+			Description: "The sequence number assigned to a message sent to a FIFO destination.",
+			Computed:    true,
+		},
+		"status": schema.StringAttribute{
+			Description: "Either `success` or `failed`.",
+			Computed:    true,
+		},
+	}
+}
+
+func readBatchEntryResults(ctx context.Context, list types.List) (map[string]BatchEntryResultModel, diag.Diagnostics) {
+	results := make(map[string]BatchEntryResultModel)
+
+	var entries []BatchEntryResultModel
+	diags := list.ElementsAs(ctx, &entries, false)
+	for _, entry := range entries {
+		results[entry.Id.ValueString()] = entry
+	}
+
+	return results, diags
+}
+
+func batchEntryResultsValue(ctx context.Context, ids []string, results map[string]BatchEntryResultModel) (types.List, diag.Diagnostics) {
+	ordered := make([]BatchEntryResultModel, 0, len(ids))
+	for _, id := range ids {
+		if result, ok := results[id]; ok {
+			ordered = append(ordered, result)
+		}
+	}
+
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: batchEntryResultAttrTypes}, ordered)
+}
+
+func batchEntryResultsValueFromSlice(ctx context.Context, ids []string, results []BatchEntryResultModel) (types.List, diag.Diagnostics) {
+	byId := make(map[string]BatchEntryResultModel)
+	for _, result := range results {
+		byId[result.Id.ValueString()] = result
+	}
+
+	return batchEntryResultsValue(ctx, ids, byId)
+}
+
+// batchEntryDeduplicationId hashes the entry identity so the ID stays within the 128 character limit
+// for any valid entry ID. The send count plays the same role as in lifecycleSendId.
+func batchEntryDeduplicationId(eventId string, entryId string, lifeCycle string, sendCount int64, messageBody string) string {
+	hash := sha256.Sum256([]byte(strings.Join([]string{eventId, entryId, lifeCycle, strconv.FormatInt(sendCount, 10), createMD5OfMessageBody(messageBody)}, "|")))
+	return hex.EncodeToString(hash[:])
+}
+
+// batchEntrySendCount returns the number of messages already sent for an entry, which is only stored once a send succeeds.
+func batchEntrySendCount(results map[string]BatchEntryResultModel, id string) int64 {
+	return results[id].SendCount.ValueInt64()
+}
+
+// updateBatchEntries sends the lifecycle events of an update and records the results of sent entries. Only entries
+// that are new, have a changed body or previously failed are sent, followed by the delete events of removed entries.
+// It returns the entries to keep in state, which still include removed entries whose delete event was not sent
+// when an error stops the update, so the next apply sends it.
+func updateBatchEntries[T batchEntry](planEntries []T, stateEntries []T, results map[string]BatchEntryResultModel, createOnly bool, errorSummary string, diags *diag.Diagnostics, send func(entries []T, lifeCycle string) ([]BatchEntryResultModel, error)) []T {
+	var createEntries, updateEntries []T
+	planIds := make(map[string]bool)
+	for _, entry := range planEntries {
+		planIds[entry.entryId()] = true
+
+		previous, ok := results[entry.entryId()]
+		switch {
+		case !ok:
+			createEntries = append(createEntries, entry)
+		case previous.Status.ValueString() != "success" || previous.MD5OfMessageBody.ValueString() != createMD5OfMessageBody(entry.entryMessageBody()):
+			updateEntries = append(updateEntries, entry)
+		}
+	}
+
+	var deleteEntries []T
+	for _, entry := range stateEntries {
+		if !planIds[entry.entryId()] {
+			deleteEntries = append(deleteEntries, entry)
+		}
+	}
+
+	var err error
+	for _, group := range []struct {
+		entries   []T
+		lifeCycle string
+	}{
+		{entries: createEntries, lifeCycle: "create"},
+		{entries: updateEntries, lifeCycle: "update"},
+	} {
+		if len(group.entries) == 0 {
+			continue
+		}
+
+		var sent []BatchEntryResultModel
+		sent, err = send(group.entries, group.lifeCycle)
+		for _, result := range sent {
+			results[result.Id.ValueString()] = result
+		}
+		addBatchEntryFailureWarnings(diags, sent, "update")
+
+		if err != nil {
+			break
+		}
+	}
+
+	if err == nil && len(deleteEntries) > 0 && !createOnly {
+		var sent []BatchEntryResultModel
+		sent, err = send(deleteEntries, "delete")
+		addBatchEntryFailureWarnings(diags, sent, "delete")
+
+		deleted := make(map[string]bool)
+		for _, result := range sent {
+			deleted[result.Id.ValueString()] = true
+		}
+
+		var pending []T
+		for _, entry := range deleteEntries {
+			if !deleted[entry.entryId()] {
+				pending = append(pending, entry)
+			}
+		}
+		deleteEntries = pending
+	}
+
+	if err == nil {
+		return planEntries
+	}
+
+	diags.AddError(errorSummary, err.Error())
+	if createOnly {
+		return planEntries
+	}
+	return append(planEntries, deleteEntries...)
+}
+
+func addBatchEntryFailureWarnings(diags *diag.Diagnostics, results []BatchEntryResultModel, lifeCycle string) {
+	// Failed entries are only retried when an update is applied, which requires a change to the configuration
+	detail := "Change the entries or replace the resource to send it again."
+	if lifeCycle == "delete" {
+		detail = "The delete event for the entry is not sent again."
+	}
+
+	for _, result := range results {
+		if result.Status.ValueString() == "failed" {
+			diags.AddWarning(
+				fmt.Sprintf("Batch entry %s failed.", result.Id.ValueString()),
+				fmt.Sprintf("%s: %s. %s", result.ErrorCode.ValueString(), result.ErrorMessage.ValueString(), detail),
+			)
+		}
+	}
+}
+
+func replaceListIfCreateOnlySet(ctx context.Context, request planmodifier.ListRequest, response *listplanmodifier.RequiresReplaceIfFuncResponse) {
+	var createOnly types.Bool
+
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("create_only"), &createOnly)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !createOnly.IsNull() {
+		if createOnly.ValueBool() {
+			response.RequiresReplace = true
+		}
+	}
+}
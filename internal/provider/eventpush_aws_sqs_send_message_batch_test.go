@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestAccEventPushSQSSendMessageBatch_Simple(t *testing.T) {
+	config1 := `
+resource "eventpush_aws_sqs_send_message_batch" "test" {
+  queue_url = "https://sqs.us-east-2.amazonaws.com/242306084486/TestQueue"
+
+  entry {
+    id           = "first"
+    message_body = "test message 1"
+  }
+
+  entry {
+    id           = "second"
+    message_body = "test message 2"
+  }
+}
+`
+
+	config2 := `
+resource "eventpush_aws_sqs_send_message_batch" "test" {
+  queue_url = "https://sqs.us-east-2.amazonaws.com/242306084486/TestQueue"
+
+  entry {
+    id           = "first"
+    message_body = "test message 1"
+  }
+
+  entry {
+    id           = "second"
+    message_body = "test message 3"
+  }
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_aws_sqs_send_message_batch.test", "queue_url", "https://sqs.us-east-2.amazonaws.com/242306084486/TestQueue"),
+					resource.TestCheckResourceAttr("eventpush_aws_sqs_send_message_batch.test", "results.#", "2"),
+					resource.TestCheckResourceAttr("eventpush_aws_sqs_send_message_batch.test", "results.0.status", "success"),
+					resource.TestCheckResourceAttrSet("eventpush_aws_sqs_send_message_batch.test", "results.0.message_id"),
+				),
+			},
+			{
+				RefreshState: true,
+			},
+			{
+				Config: config2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_aws_sqs_send_message_batch.test", "queue_url", "https://sqs.us-east-2.amazonaws.com/242306084486/TestQueue"),
+					resource.TestCheckResourceAttr("eventpush_aws_sqs_send_message_batch.test", "results.#", "2"),
+					resource.TestCheckResourceAttr("eventpush_aws_sqs_send_message_batch.test", "results.1.md5_of_message_body", "6ab756a597bb7fd5e62a310fe107f572"),
+					resource.TestCheckResourceAttrSet("eventpush_aws_sqs_send_message_batch.test", "results.1.message_id"),
+				),
+			},
+			{
+				Config:  config2,
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func TestBatchEntryDeduplicationId(t *testing.T) {
+	cases := []struct {
+		entryId   string
+		lifeCycle string
+		sendCount int64
+	}{
+		{entryId: "1", lifeCycle: "create", sendCount: 1},
+		{entryId: strings.Repeat("a", 80), lifeCycle: "create", sendCount: 1},
+		{entryId: strings.Repeat("a", 80), lifeCycle: "update", sendCount: 2},
+		{entryId: strings.Repeat("a", 80), lifeCycle: "update", sendCount: 4},
+		{entryId: strings.Repeat("a", 80), lifeCycle: "delete", sendCount: 5},
+	}
+
+	seen := make(map[string]bool)
+	for _, c := range cases {
+		id := batchEntryDeduplicationId("0b9c8d2e-5f7e-4c47-9d3f-8a6f5e0c1b2a", c.entryId, c.lifeCycle, c.sendCount, "test message 1")
+		if len(id) > 128 {
+			t.Errorf("deduplication ID for entry %q is %d characters, expected at most 128", c.entryId, len(id))
+		}
+		if !batchEntryIdRegexp.MatchString(id) {
+			t.Errorf("deduplication ID %q contains invalid characters", id)
+		}
+		if seen[id] {
+			t.Errorf("deduplication ID for entry %q, lifecycle %s and send count %d is not unique", c.entryId, c.lifeCycle, c.sendCount)
+		}
+		seen[id] = true
+	}
+}
+
+func TestUpdateBatchEntries(t *testing.T) {
+	entry := func(id string, body string) SQSBatchEntryModel {
+		return SQSBatchEntryModel{Id: types.StringValue(id), MessageBody: types.StringValue(body)}
+	}
+	result := func(id string, body string, status string) BatchEntryResultModel {
+		return BatchEntryResultModel{
+			Id:               types.StringValue(id),
+			MD5OfMessageBody: types.StringValue(createMD5OfMessageBody(body)),
+			SendCount:        types.Int64Value(1),
+			Status:           types.StringValue(status),
+		}
+	}
+
+	stateEntries := []SQSBatchEntryModel{entry("kept", "a"), entry("changed", "a"), entry("failed", "a"), entry("removed", "a")}
+	planEntries := []SQSBatchEntryModel{entry("kept", "a"), entry("changed", "b"), entry("failed", "a"), entry("added", "a")}
+
+	cases := []struct {
+		name        string
+		failOn      string
+		wantSent    map[string][]string
+		wantEntries []string
+		wantError   bool
+	}{
+		{
+			name:        "all sent",
+			wantSent:    map[string][]string{"create": {"added"}, "update": {"changed", "failed"}, "delete": {"removed"}},
+			wantEntries: []string{"kept", "changed", "failed", "added"},
+		},
+		{
+			name:        "update fails",
+			failOn:      "update",
+			wantSent:    map[string][]string{"create": {"added"}, "update": {"changed", "failed"}},
+			wantEntries: []string{"kept", "changed", "failed", "added", "removed"},
+			wantError:   true,
+		},
+		{
+			name:        "delete fails",
+			failOn:      "delete",
+			wantSent:    map[string][]string{"create": {"added"}, "update": {"changed", "failed"}, "delete": {"removed"}},
+			wantEntries: []string{"kept", "changed", "failed", "added", "removed"},
+			wantError:   true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			results := map[string]BatchEntryResultModel{
+				"kept":    result("kept", "a", "success"),
+				"changed": result("changed", "a", "success"),
+				"failed":  result("failed", "a", "failed"),
+				"removed": result("removed", "a", "success"),
+			}
+
+			sent := make(map[string][]string)
+			var diags diag.Diagnostics
+			entries := updateBatchEntries(planEntries, stateEntries, results, false, "Error sending message batch.", &diags,
+				func(entries []SQSBatchEntryModel, lifeCycle string) ([]BatchEntryResultModel, error) {
+					for _, entry := range entries {
+						sent[lifeCycle] = append(sent[lifeCycle], entry.Id.ValueString())
+					}
+					if lifeCycle == c.failOn {
+						return nil, fmt.Errorf("send failed")
+					}
+
+					var entryResults []BatchEntryResultModel
+					for _, entry := range entries {
+						entryResults = append(entryResults, result(entry.Id.ValueString(), entry.MessageBody.ValueString(), "success"))
+					}
+					return entryResults, nil
+				})
+
+			if !reflect.DeepEqual(sent, c.wantSent) {
+				t.Errorf("sent %v, expected %v", sent, c.wantSent)
+			}
+			if ids := sqsBatchEntryIds(entries); !reflect.DeepEqual(ids, c.wantEntries) {
+				t.Errorf("kept entries %v, expected %v", ids, c.wantEntries)
+			}
+			if diags.HasError() != c.wantError {
+				t.Errorf("error %v, expected %v", diags.HasError(), c.wantError)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
 	"testing"
 )
 
@@ -69,3 +70,67 @@ resource "eventpush_aws_sqs_send_message" "test" {
 		},
 	})
 }
+
+func TestAccEventPushSQSSendMessage_FIFO(t *testing.T) {
+	config1 := `
+resource "eventpush_aws_sqs_send_message" "test" {
+  message_body     = "test message 1"
+  message_group_id = "test"
+  queue_url        = "https://sqs.us-east-2.amazonaws.com/242306084486/TestQueue.fifo"
+}
+`
+
+	config2 := `
+resource "eventpush_aws_sqs_send_message" "test" {
+  message_body     = "test message 2"
+  message_group_id = "test"
+  queue_url        = "https://sqs.us-east-2.amazonaws.com/242306084486/TestQueue.fifo"
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_aws_sqs_send_message.test", "message_group_id", "test"),
+					resource.TestCheckResourceAttrSet("eventpush_aws_sqs_send_message.test", "sequence_number"),
+					resource.TestCheckResourceAttr("eventpush_aws_sqs_send_message.test", "send_count", "1"),
+				),
+			},
+			{
+				RefreshState: true,
+			},
+			{
+				Config: config2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_aws_sqs_send_message.test", "message_group_id", "test"),
+					resource.TestCheckResourceAttrSet("eventpush_aws_sqs_send_message.test", "sequence_number"),
+					resource.TestCheckResourceAttr("eventpush_aws_sqs_send_message.test", "send_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEventPushSQSSendMessage_FIFODelaySeconds(t *testing.T) {
+	config1 := `
+resource "eventpush_aws_sqs_send_message" "test" {
+  message_body     = "test message 1"
+  message_group_id = "test"
+  delay_seconds    = 10
+  queue_url        = "https://sqs.us-east-2.amazonaws.com/242306084486/TestQueue.fifo"
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config1,
+				ExpectError: regexp.MustCompile("Invalid attribute for FIFO queue"),
+			},
+		},
+	})
+}
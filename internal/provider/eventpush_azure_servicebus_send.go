@@ -0,0 +1,476 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/url"
+	"strings"
+	"time"
+)
+
+var _ resource.Resource = &AzureServiceBusSendResource{}
+var _ resource.ResourceWithConfigure = &AzureServiceBusSendResource{}
+var _ resource.ResourceWithValidateConfig = &AzureServiceBusSendResource{}
+
+type AzureServiceBusSendResource struct {
+	AWSClient          *AWSClient
+	AzureConfigOptions AzureConfigOptions
+}
+
+type AzureServiceBusSendResourceModel struct {
+	ApplicationProperties types.Map                    `tfsdk:"application_properties"`
+	ContentType           types.String                 `tfsdk:"content_type"`
+	CorrelationId         types.String                 `tfsdk:"correlation_id"`
+	CreateOnly            types.Bool                   `tfsdk:"create_only"`
+	EventId               types.String                 `tfsdk:"event_id"`
+	KMSSignature          []KMSSignatureAttributeModel `tfsdk:"kms_signature"`
+	MD5OfMessageBody      types.String                 `tfsdk:"md5_of_message_body"`
+	MessageBody           types.String                 `tfsdk:"message_body"`
+	MessageId             types.String                 `tfsdk:"message_id"`
+	QueueName             types.String                 `tfsdk:"queue_name"`
+	ScheduledEnqueueTime  types.String                 `tfsdk:"scheduled_enqueue_time"`
+	SendCount             types.Int64                  `tfsdk:"send_count"`
+	SessionId             types.String                 `tfsdk:"session_id"`
+	TimeToLive            types.String                 `tfsdk:"time_to_live"`
+	TopicName             types.String                 `tfsdk:"topic_name"`
+}
+
+func newAzureServiceBusSendResource() resource.Resource {
+	return &AzureServiceBusSendResource{}
+}
+
+func (r *AzureServiceBusSendResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerMeta := request.ProviderData.(Meta)
+
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("unable to load SDK config", err.Error())
+		return
+	}
+
+	if providerMeta.AWSConfigOptions.Region != "" {
+		cfg.Region = providerMeta.AWSConfigOptions.Region
+	}
+
+	kmsClient := kms.NewFromConfig(cfg)
+
+	r.AWSClient = &AWSClient{
+		KMSClient: kmsClient,
+		Region:    providerMeta.AWSConfigOptions.Region,
+	}
+	r.AzureConfigOptions = providerMeta.AzureConfigOptions
+}
+
+func (r *AzureServiceBusSendResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_azure_servicebus_send"
+}
+
+func (r *AzureServiceBusSendResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Send a message to an Azure Service Bus queue or topic.",
+		Attributes: map[string]schema.Attribute{
+			"application_properties": schema.MapAttribute{
+				Description: "Application properties to send with the message.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"content_type": schema.StringAttribute{
+				Description: "The MIME content type of the message.",
+				Optional:    true,
+			},
+			"correlation_id": schema.StringAttribute{
+				Description: "The correlation ID of the message.",
+				Optional:    true,
+			},
+			"create_only": schema.BoolAttribute{
+				Description: "When enabled, forces resource to be replaced on update.",
+				Optional:    true,
+			},
+			"event_id": schema.StringAttribute{
+				Description: "Generated ID for resource tracking.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"md5_of_message_body": schema.StringAttribute{
+				Description: "The MD5 of the message body.",
+				Computed:    true,
+			},
+			"message_body": schema.StringAttribute{
+				Description: "The message to send.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(replaceIfCreateOnlySet, "Forces replacement of resource.", "Forces replacement of resource."),
+				},
+			},
+			"message_id": schema.StringAttribute{
+				Description: "The message ID of the create event, used for duplicate detection. Defaults to the event ID. Update and delete events always use the event ID followed by the lifecycle event, the send count and the MD5 of the message body so they are not dropped as duplicates.",
+				Optional:    true,
+			},
+			"queue_name": schema.StringAttribute{
+				Description: "The name of the queue.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("topic_name")),
+				},
+			},
+			"scheduled_enqueue_time": schema.StringAttribute{
+				Description: "The time the message is enqueued, in RFC 3339 format. Until then the message is not visible to receivers.",
+				Optional:    true,
+			},
+			"send_count": schema.Int64Attribute{
+				Description: "The number of messages sent. Used in the message ID of update and delete events so each send has a new ID.",
+				Computed:    true,
+			},
+			"session_id": schema.StringAttribute{
+				Description: "The session ID of the message. Required by session-enabled queues and subscriptions.",
+				Optional:    true,
+			},
+			"time_to_live": schema.StringAttribute{
+				Description: "How long the message is kept before it expires, as a duration such as `24h`.",
+				Optional:    true,
+			},
+			"topic_name": schema.StringAttribute{
+				Description: "The name of the topic.",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"kms_signature": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"kms_key_id": schema.StringAttribute{
+							Description: "The ID of the AWS KMS key.",
+							Required:    true,
+						},
+						"message_attribute": schema.StringAttribute{
+							Description: "Application property name to add signature value.",
+							Optional:    true,
+						},
+						"algorithm": schema.StringAttribute{
+							Description: "The KMS signature algorithm.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOfCaseInsensitive(
+									[]string{
+										string(kmstypes.SigningAlgorithmSpecRsassaPkcs1V15Sha256),
+									}...,
+								),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *AzureServiceBusSendResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data AzureServiceBusSendResourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.TimeToLive.IsNull() && !data.TimeToLive.IsUnknown() {
+		timeToLive, err := time.ParseDuration(data.TimeToLive.ValueString())
+		if err != nil || timeToLive <= 0 {
+			response.Diagnostics.AddAttributeError(
+				path.Root("time_to_live"),
+				"Invalid time to live",
+				"The time to live must be a positive duration such as 30m or 24h.",
+			)
+		}
+	}
+
+	if !data.ScheduledEnqueueTime.IsNull() && !data.ScheduledEnqueueTime.IsUnknown() {
+		if _, err := time.Parse(time.RFC3339, data.ScheduledEnqueueTime.ValueString()); err != nil {
+			response.Diagnostics.AddAttributeError(
+				path.Root("scheduled_enqueue_time"),
+				"Invalid scheduled enqueue time",
+				"The scheduled enqueue time must be in RFC 3339 format, such as 2030-01-02T15:04:05Z.",
+			)
+		}
+	}
+}
+
+func (r *AzureServiceBusSendResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data AzureServiceBusSendResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Event ID is set before sending since it is the default message ID
+	data.EventId = types.StringValue(uuid.New().String())
+	data.SendCount = types.Int64Value(0)
+
+	err := sendServiceBusMessage(ctx, r, &data, "create")
+	if err != nil {
+		response.Diagnostics.AddError("Error sending message to Service Bus.", err.Error())
+		return
+	}
+
+	data.MD5OfMessageBody = types.StringValue(createMD5OfMessageBody(data.MessageBody.ValueString()))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *AzureServiceBusSendResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data AzureServiceBusSendResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *AzureServiceBusSendResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state AzureServiceBusSendResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	planMessageBodyMD5 := createMD5OfMessageBody(plan.MessageBody.ValueString())
+	stateMessageBodyMD5 := createMD5OfMessageBody(state.MessageBody.ValueString())
+
+	plan.SendCount = state.SendCount
+	if planMessageBodyMD5 != stateMessageBodyMD5 {
+		err := sendServiceBusMessage(ctx, r, &plan, "update")
+		if err != nil {
+			response.Diagnostics.AddError("Error sending message to Service Bus.", err.Error())
+			return
+		}
+	}
+	plan.MD5OfMessageBody = types.StringValue(planMessageBodyMD5)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *AzureServiceBusSendResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data AzureServiceBusSendResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.CreateOnly.ValueBool() {
+		err := sendServiceBusMessage(ctx, r, &data, "delete")
+		if err != nil {
+			response.Diagnostics.AddError("Error sending message to Service Bus.", err.Error())
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func sendServiceBusMessage(ctx context.Context, r *AzureServiceBusSendResource, data *AzureServiceBusSendResourceModel, lifeCycle string) error {
+	applicationProperties := make(map[string]string)
+	message := &azservicebus.Message{
+		Body:                  []byte(data.MessageBody.ValueString()),
+		ApplicationProperties: make(map[string]any),
+	}
+
+	if !data.ApplicationProperties.IsNull() {
+		diags := data.ApplicationProperties.ElementsAs(ctx, &applicationProperties, false)
+		if diags.HasError() {
+			return fmt.Errorf("unable to read application properties")
+		}
+	}
+
+	if data.KMSSignature != nil {
+		attrName, signature, err := signMessageWithKMSSignatureBlock(ctx, r.AWSClient.KMSClient, data.KMSSignature[0], data.MessageBody.ValueString())
+		if err != nil {
+			return err
+		}
+		applicationProperties[attrName] = signature
+	}
+
+	applicationProperties["X-LifeCycle-Event"] = lifeCycle
+
+	for key, value := range applicationProperties {
+		message.ApplicationProperties[key] = value
+	}
+
+	sendCount := data.SendCount.ValueInt64() + 1
+	message.MessageID = to.Ptr(serviceBusMessageId(data, lifeCycle, sendCount))
+
+	if !data.ContentType.IsNull() {
+		message.ContentType = to.Ptr(data.ContentType.ValueString())
+	}
+
+	if !data.CorrelationId.IsNull() {
+		message.CorrelationID = to.Ptr(data.CorrelationId.ValueString())
+	}
+
+	if !data.SessionId.IsNull() {
+		message.SessionID = to.Ptr(data.SessionId.ValueString())
+	}
+
+	if !data.TimeToLive.IsNull() {
+		timeToLive, err := time.ParseDuration(data.TimeToLive.ValueString())
+		if err != nil {
+			return err
+		}
+		message.TimeToLive = &timeToLive
+	}
+
+	if !data.ScheduledEnqueueTime.IsNull() {
+		scheduledEnqueueTime, err := time.Parse(time.RFC3339, data.ScheduledEnqueueTime.ValueString())
+		if err != nil {
+			return err
+		}
+		message.ScheduledEnqueueTime = &scheduledEnqueueTime
+	}
+
+	client, err := newServiceBusClient(r.AzureConfigOptions)
+	if err != nil {
+		return err
+	}
+	defer client.Close(ctx)
+
+	entityName := data.QueueName.ValueString()
+	if !data.TopicName.IsNull() {
+		entityName = data.TopicName.ValueString()
+	}
+
+	sender, err := client.NewSender(entityName, nil)
+	if err != nil {
+		return err
+	}
+	defer sender.Close(ctx)
+
+	err = sender.SendMessage(ctx, message, nil)
+	if err != nil {
+		return err
+	}
+
+	data.SendCount = types.Int64Value(sendCount)
+
+	return nil
+}
+
+// serviceBusMessageId returns the message ID for a lifecycle event. The create event keeps the configured
+// message ID or the event ID, later events need their own ID to pass duplicate detection.
+func serviceBusMessageId(data *AzureServiceBusSendResourceModel, lifeCycle string, sendCount int64) string {
+	if lifeCycle != "create" {
+		return lifecycleSendId(data.EventId.ValueString(), lifeCycle, sendCount, data.MessageBody.ValueString())
+	}
+
+	if !data.MessageId.IsNull() {
+		return data.MessageId.ValueString()
+	}
+
+	return data.EventId.ValueString()
+}
+
+func newServiceBusClient(options AzureConfigOptions) (*azservicebus.Client, error) {
+	if options.ServiceBusConnectionString != "" {
+		connectionString := options.ServiceBusConnectionString
+		if options.ServiceBusEndpoint != "" {
+			var err error
+			connectionString, err = setServiceBusConnectionStringEndpoint(connectionString, options.ServiceBusEndpoint)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return azservicebus.NewClientFromConnectionString(connectionString, nil)
+	}
+
+	if options.ServiceBusEndpoint == "" {
+		return nil, fmt.Errorf("servicebus_connection_string or servicebus_endpoint must be set in the azure provider block")
+	}
+
+	endpoint, err := url.Parse(options.ServiceBusEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse servicebus_endpoint: %w", err)
+	}
+
+	credential, err := newAzureCredential(options)
+	if err != nil {
+		return nil, err
+	}
+
+	return azservicebus.NewClient(endpoint.Host, credential, nil)
+}
+
+// setServiceBusConnectionStringEndpoint replaces the endpoint of a connection string. Local endpoints
+// are flagged for the Service Bus emulator, which does not use TLS.
+func setServiceBusConnectionStringEndpoint(connectionString, endpoint string) (string, error) {
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse servicebus_endpoint: %w", err)
+	}
+
+	useEmulator := endpointURL.Hostname() == "localhost" || endpointURL.Hostname() == "127.0.0.1"
+
+	var properties []string
+	for _, property := range strings.Split(connectionString, ";") {
+		key, _, _ := strings.Cut(property, "=")
+		switch {
+		case property == "":
+		case strings.EqualFold(key, "Endpoint"):
+		case strings.EqualFold(key, "UseDevelopmentEmulator"):
+			if !useEmulator {
+				properties = append(properties, property)
+			}
+		default:
+			properties = append(properties, property)
+		}
+	}
+
+	properties = append([]string{"Endpoint=" + endpoint}, properties...)
+	if useEmulator {
+		properties = append(properties, "UseDevelopmentEmulator=true")
+	}
+
+	return strings.Join(properties, ";"), nil
+}
+
+func newAzureCredential(options AzureConfigOptions) (azcore.TokenCredential, error) {
+	if options.ClientID != "" && options.ClientSecret != "" {
+		return azidentity.NewClientSecretCredential(options.TenantID, options.ClientID, options.ClientSecret, nil)
+	}
+
+	return azidentity.NewDefaultAzureCredential(nil)
+}
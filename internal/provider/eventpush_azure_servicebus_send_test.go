@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccEventPushAzureServiceBusSend_Simple(t *testing.T) {
+	config1 := `
+provider "eventpush" {
+  azure {
+    servicebus_connection_string = "Endpoint=sb://localhost;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=SAS_KEY_VALUE;UseDevelopmentEmulator=true;"
+  }
+}
+
+resource "eventpush_azure_servicebus_send" "test" {
+  queue_name   = "eventpush-test"
+  message_body = "test message 1"
+}
+`
+
+	config2 := `
+provider "eventpush" {
+  azure {
+    servicebus_connection_string = "Endpoint=sb://localhost;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=SAS_KEY_VALUE;UseDevelopmentEmulator=true;"
+  }
+}
+
+resource "eventpush_azure_servicebus_send" "test" {
+  queue_name   = "eventpush-test"
+  message_body = "test message 2"
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_azure_servicebus_send.test", "queue_name", "eventpush-test"),
+					resource.TestCheckResourceAttr("eventpush_azure_servicebus_send.test", "message_body", "test message 1"),
+					resource.TestCheckResourceAttrSet("eventpush_azure_servicebus_send.test", "event_id"),
+					resource.TestCheckResourceAttr("eventpush_azure_servicebus_send.test", "send_count", "1"),
+				),
+			},
+			{
+				RefreshState: true,
+			},
+			{
+				Config: config2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_azure_servicebus_send.test", "queue_name", "eventpush-test"),
+					resource.TestCheckResourceAttr("eventpush_azure_servicebus_send.test", "message_body", "test message 2"),
+					resource.TestCheckResourceAttrSet("eventpush_azure_servicebus_send.test", "event_id"),
+					resource.TestCheckResourceAttr("eventpush_azure_servicebus_send.test", "send_count", "2"),
+				),
+			},
+			{
+				Config:  config2,
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func TestServiceBusMessageId(t *testing.T) {
+	eventId := "0b9c8d2e-5f7e-4c47-9d3f-8a6f5e0c1b2a"
+	bodyMD5 := createMD5OfMessageBody("test message 1")
+
+	cases := []struct {
+		messageId types.String
+		lifeCycle string
+		sendCount int64
+		expected  string
+	}{
+		{messageId: types.StringNull(), lifeCycle: "create", sendCount: 1, expected: eventId},
+		{messageId: types.StringValue("order-1"), lifeCycle: "create", sendCount: 1, expected: "order-1"},
+		{messageId: types.StringNull(), lifeCycle: "update", sendCount: 2, expected: eventId + "-update-2-" + bodyMD5},
+		{messageId: types.StringValue("order-1"), lifeCycle: "update", sendCount: 2, expected: eventId + "-update-2-" + bodyMD5},
+		{messageId: types.StringValue("order-1"), lifeCycle: "delete", sendCount: 3, expected: eventId + "-delete-3-" + bodyMD5},
+	}
+
+	for _, c := range cases {
+		data := &AzureServiceBusSendResourceModel{
+			EventId:     types.StringValue(eventId),
+			MessageBody: types.StringValue("test message 1"),
+			MessageId:   c.messageId,
+		}
+
+		actual := serviceBusMessageId(data, c.lifeCycle, c.sendCount)
+		if actual != c.expected {
+			t.Errorf("serviceBusMessageId(%s, %s, %d) = %q, expected %q", c.messageId, c.lifeCycle, c.sendCount, actual, c.expected)
+		}
+	}
+}
+
+func TestSetServiceBusConnectionStringEndpoint(t *testing.T) {
+	cases := []struct {
+		connectionString string
+		endpoint         string
+		expected         string
+	}{
+		{
+			connectionString: "Endpoint=sb://example.servicebus.windows.net/;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=key",
+			endpoint:         "sb://other.servicebus.windows.net/",
+			expected:         "Endpoint=sb://other.servicebus.windows.net/;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=key",
+		},
+		{
+			connectionString: "Endpoint=sb://example.servicebus.windows.net/;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=key;",
+			endpoint:         "sb://localhost",
+			expected:         "Endpoint=sb://localhost;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=key;UseDevelopmentEmulator=true",
+		},
+		{
+			connectionString: "Endpoint=sb://localhost;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=key;UseDevelopmentEmulator=true",
+			endpoint:         "sb://127.0.0.1:5672",
+			expected:         "Endpoint=sb://127.0.0.1:5672;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=key;UseDevelopmentEmulator=true",
+		},
+		{
+			connectionString: "endpoint=sb://localhost;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=key;UseDevelopmentEmulator=true",
+			endpoint:         "sb://example.servicebus.windows.net/",
+			expected:         "Endpoint=sb://example.servicebus.windows.net/;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=key;UseDevelopmentEmulator=true",
+		},
+	}
+
+	for _, c := range cases {
+		actual, err := setServiceBusConnectionStringEndpoint(c.connectionString, c.endpoint)
+		if err != nil {
+			t.Errorf("setServiceBusConnectionStringEndpoint(%q, %q) returned error: %s", c.connectionString, c.endpoint, err)
+			continue
+		}
+		if actual != c.expected {
+			t.Errorf("setServiceBusConnectionStringEndpoint(%q, %q) = %q, expected %q", c.connectionString, c.endpoint, actual, c.expected)
+		}
+	}
+
+	_, err := setServiceBusConnectionStringEndpoint("Endpoint=sb://localhost", "://invalid")
+	if err == nil {
+		t.Errorf("setServiceBusConnectionStringEndpoint with an invalid endpoint expected an error")
+	}
+}
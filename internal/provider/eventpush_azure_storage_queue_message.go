@@ -0,0 +1,492 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const storageQueueServiceVersion = "2021-12-02"
+
+var _ resource.Resource = &AzureStorageQueueMessageResource{}
+var _ resource.ResourceWithConfigure = &AzureStorageQueueMessageResource{}
+var _ resource.ResourceWithValidateConfig = &AzureStorageQueueMessageResource{}
+
+type AzureStorageQueueMessageResource struct {
+	AWSClient          *AWSClient
+	AzureConfigOptions AzureConfigOptions
+}
+
+type AzureStorageQueueMessageResourceModel struct {
+	Base64Encode             types.Bool                   `tfsdk:"base64_encode"`
+	CreateOnly               types.Bool                   `tfsdk:"create_only"`
+	Envelope                 types.Bool                   `tfsdk:"envelope"`
+	EventId                  types.String                 `tfsdk:"event_id"`
+	ExpirationTime           types.String                 `tfsdk:"expiration_time"`
+	KMSSignature             []KMSSignatureAttributeModel `tfsdk:"kms_signature"`
+	MD5OfMessageBody         types.String                 `tfsdk:"md5_of_message_body"`
+	MessageBody              types.String                 `tfsdk:"message_body"`
+	MessageId                types.String                 `tfsdk:"message_id"`
+	QueueName                types.String                 `tfsdk:"queue_name"`
+	TTLSeconds               types.Int64                  `tfsdk:"ttl_seconds"`
+	VisibilityTimeoutSeconds types.Int64                  `tfsdk:"visibility_timeout_seconds"`
+}
+
+type storageQueueMessage struct {
+	MessageId      string `xml:"MessageId"`
+	ExpirationTime string `xml:"ExpirationTime"`
+}
+
+type storageQueueMessagesList struct {
+	XMLName  xml.Name              `xml:"QueueMessagesList"`
+	Messages []storageQueueMessage `xml:"QueueMessage"`
+}
+
+type storageQueueError struct {
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+func newAzureStorageQueueMessageResource() resource.Resource {
+	return &AzureStorageQueueMessageResource{}
+}
+
+func (r *AzureStorageQueueMessageResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerMeta := request.ProviderData.(Meta)
+
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("unable to load SDK config", err.Error())
+		return
+	}
+
+	if providerMeta.AWSConfigOptions.Region != "" {
+		cfg.Region = providerMeta.AWSConfigOptions.Region
+	}
+
+	kmsClient := kms.NewFromConfig(cfg)
+
+	r.AWSClient = &AWSClient{
+		KMSClient: kmsClient,
+		Region:    providerMeta.AWSConfigOptions.Region,
+	}
+	r.AzureConfigOptions = providerMeta.AzureConfigOptions
+}
+
+func (r *AzureStorageQueueMessageResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_azure_storage_queue_message"
+}
+
+func (r *AzureStorageQueueMessageResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Put a message on an Azure Storage queue.",
+		Attributes: map[string]schema.Attribute{
+			"base64_encode": schema.BoolAttribute{
+				Description: "When enabled, the message is base64 encoded as expected by the Azure Functions queue trigger. Defaults to true.",
+				Optional:    true,
+			},
+			"create_only": schema.BoolAttribute{
+				Description: "When enabled, forces resource to be replaced on update.",
+				Optional:    true,
+			},
+			"envelope": schema.BoolAttribute{
+				Description: "When enabled, the message is sent as a JSON envelope containing the message body, the lifecycle event and the KMS signature. Storage queue messages have no attributes, so this is the only way to send the lifecycle event with it. Defaults to true. Disabling it requires `create_only`.",
+				Optional:    true,
+			},
+			"event_id": schema.StringAttribute{
+				Description: "Generated ID for resource tracking.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expiration_time": schema.StringAttribute{
+				Description: "The time the last message put expires, in RFC 3339 format.",
+				Computed:    true,
+			},
+			"md5_of_message_body": schema.StringAttribute{
+				Description: "The MD5 of the message body.",
+				Computed:    true,
+			},
+			"message_body": schema.StringAttribute{
+				Description: "The message to send.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(replaceIfCreateOnlySet, "Forces replacement of resource.", "Forces replacement of resource."),
+				},
+			},
+			"message_id": schema.StringAttribute{
+				Description: "The ID of the last message put.",
+				Computed:    true,
+			},
+			"queue_name": schema.StringAttribute{
+				Description: "The name of the queue.",
+				Required:    true,
+			},
+			"ttl_seconds": schema.Int64Attribute{
+				Description: "How many seconds the message is kept before it expires, or -1 for a message that never expires. Defaults to 7 days.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.AtLeast(1),
+					),
+				},
+			},
+			"visibility_timeout_seconds": schema.Int64Attribute{
+				Description: "How many seconds the message is hidden from receivers after it is put, up to 7 days. Defaults to 0.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 604800),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"kms_signature": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"kms_key_id": schema.StringAttribute{
+							Description: "The ID of the AWS KMS key.",
+							Required:    true,
+						},
+						"message_attribute": schema.StringAttribute{
+							Description: "Envelope key to add signature value.",
+							Optional:    true,
+						},
+						"algorithm": schema.StringAttribute{
+							Description: "The KMS signature algorithm.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOfCaseInsensitive(
+									[]string{
+										string(kmstypes.SigningAlgorithmSpecRsassaPkcs1V15Sha256),
+									}...,
+								),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *AzureStorageQueueMessageResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data AzureStorageQueueMessageResourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	validateMessageEnvelope(data.Envelope, data.CreateOnly, data.KMSSignature, "Storage queue messages", &response.Diagnostics)
+}
+
+func (r *AzureStorageQueueMessageResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data AzureStorageQueueMessageResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.EventId = types.StringValue(uuid.New().String())
+
+	err := putStorageQueueMessage(ctx, r, &data, "create")
+	if err != nil {
+		response.Diagnostics.AddError("Error putting message on storage queue.", err.Error())
+		return
+	}
+
+	data.MD5OfMessageBody = types.StringValue(createMD5OfMessageBody(data.MessageBody.ValueString()))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *AzureStorageQueueMessageResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data AzureStorageQueueMessageResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *AzureStorageQueueMessageResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state AzureStorageQueueMessageResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	planMessageBodyMD5 := createMD5OfMessageBody(plan.MessageBody.ValueString())
+	stateMessageBodyMD5 := createMD5OfMessageBody(state.MessageBody.ValueString())
+
+	if planMessageBodyMD5 != stateMessageBodyMD5 {
+		err := putStorageQueueMessage(ctx, r, &plan, "update")
+		if err != nil {
+			response.Diagnostics.AddError("Error putting message on storage queue.", err.Error())
+			return
+		}
+	} else {
+		plan.ExpirationTime = state.ExpirationTime
+		plan.MessageId = state.MessageId
+	}
+	plan.MD5OfMessageBody = types.StringValue(planMessageBodyMD5)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *AzureStorageQueueMessageResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data AzureStorageQueueMessageResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.CreateOnly.ValueBool() {
+		err := putStorageQueueMessage(ctx, r, &data, "delete")
+		if err != nil {
+			response.Diagnostics.AddError("Error putting message on storage queue.", err.Error())
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func putStorageQueueMessage(ctx context.Context, r *AzureStorageQueueMessageResource, data *AzureStorageQueueMessageResourceModel, lifeCycle string) error {
+	options := r.AzureConfigOptions
+	messageText := data.MessageBody.ValueString()
+
+	// Storage queue messages have no attributes, so the lifecycle event and signature are carried in an envelope
+	if messageEnvelopeEnabled(data.Envelope) {
+		envelope, err := createMessageEnvelope(ctx, r.AWSClient.KMSClient, data.KMSSignature, data.MessageBody.ValueString(), lifeCycle)
+		if err != nil {
+			return err
+		}
+
+		envelopeJSON, err := json.Marshal(envelope)
+		if err != nil {
+			return err
+		}
+		messageText = string(envelopeJSON)
+	}
+
+	if data.Base64Encode.IsNull() || data.Base64Encode.ValueBool() {
+		messageText = base64.StdEncoding.EncodeToString([]byte(messageText))
+	}
+
+	var body bytes.Buffer
+	body.WriteString("<QueueMessage><MessageText>")
+	if err := xml.EscapeText(&body, []byte(messageText)); err != nil {
+		return err
+	}
+	body.WriteString("</MessageText></QueueMessage>")
+
+	endpoint := options.StorageQueueEndpoint
+	if endpoint == "" {
+		if options.StorageAccountName == "" {
+			return fmt.Errorf("storage_account_name or storage_queue_endpoint must be set in the azure provider block")
+		}
+		endpoint = fmt.Sprintf("https://%s.queue.core.windows.net", options.StorageAccountName)
+	}
+
+	requestURL, err := url.Parse(strings.TrimSuffix(endpoint, "/") + "/" + url.PathEscape(data.QueueName.ValueString()) + "/messages")
+	if err != nil {
+		return fmt.Errorf("unable to parse storage_queue_endpoint: %w", err)
+	}
+
+	query := url.Values{}
+	if !data.VisibilityTimeoutSeconds.IsNull() {
+		query.Set("visibilitytimeout", strconv.FormatInt(data.VisibilityTimeoutSeconds.ValueInt64(), 10))
+	}
+	if !data.TTLSeconds.IsNull() {
+		query.Set("messagettl", strconv.FormatInt(data.TTLSeconds.ValueInt64(), 10))
+	}
+
+	if options.StorageAccountKey == "" && options.StorageSASToken != "" {
+		sasQuery, err := url.ParseQuery(strings.TrimPrefix(options.StorageSASToken, "?"))
+		if err != nil {
+			return fmt.Errorf("unable to parse storage_sas_token: %w", err)
+		}
+		for key, values := range sasQuery {
+			query[key] = values
+		}
+	}
+	requestURL.RawQuery = query.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL.String(), &body)
+	if err != nil {
+		return err
+	}
+	httpRequest.Header.Set("Content-Type", "application/xml")
+	httpRequest.Header.Set("x-ms-date", time.Now().UTC().Format(http.TimeFormat))
+	httpRequest.Header.Set("x-ms-version", storageQueueServiceVersion)
+
+	if options.StorageAccountKey != "" {
+		authorization, err := signStorageSharedKeyRequest(httpRequest, options.StorageAccountName, options.StorageAccountKey)
+		if err != nil {
+			return err
+		}
+		httpRequest.Header.Set("Authorization", authorization)
+	} else if options.StorageSASToken == "" {
+		return fmt.Errorf("storage_account_key or storage_sas_token must be set in the azure provider block")
+	}
+
+	httpClient := &http.Client{Timeout: 30 * time.Second}
+	httpResponse, err := httpClient.Do(httpRequest)
+	if err != nil {
+		return err
+	}
+	defer httpResponse.Body.Close()
+
+	responseBody, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return err
+	}
+
+	if httpResponse.StatusCode != http.StatusCreated {
+		var storageError storageQueueError
+		if xml.Unmarshal(responseBody, &storageError) == nil && storageError.Code != "" {
+			return fmt.Errorf("%s: %s: %s", httpResponse.Status, storageError.Code, strings.TrimSpace(storageError.Message))
+		}
+		return fmt.Errorf("%s", httpResponse.Status)
+	}
+
+	var messagesList storageQueueMessagesList
+	err = xml.Unmarshal(responseBody, &messagesList)
+	if err != nil {
+		return fmt.Errorf("unable to read put message response: %w", err)
+	}
+	if len(messagesList.Messages) == 0 {
+		return fmt.Errorf("put message response has no message")
+	}
+
+	message := messagesList.Messages[0]
+	data.MessageId = types.StringValue(message.MessageId)
+
+	expirationTime, err := time.Parse(http.TimeFormat, message.ExpirationTime)
+	if err != nil {
+		data.ExpirationTime = types.StringValue(message.ExpirationTime)
+	} else {
+		data.ExpirationTime = types.StringValue(expirationTime.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// signStorageSharedKeyRequest builds the Shared Key authorization header for a Queue service request.
+func signStorageSharedKeyRequest(request *http.Request, accountName, accountKey string) (string, error) {
+	if accountName == "" {
+		return "", fmt.Errorf("storage_account_name must be set in the azure provider block to use storage_account_key")
+	}
+
+	key, err := base64.StdEncoding.DecodeString(accountKey)
+	if err != nil {
+		return "", fmt.Errorf("unable to decode storage_account_key: %w", err)
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(storageSharedKeyStringToSign(request, accountName)))
+	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	return fmt.Sprintf("SharedKey %s:%s", accountName, signature), nil
+}
+
+// storageSharedKeyStringToSign builds the Shared Key string-to-sign for service version 2009-09-19 and later.
+// The canonicalized resource starts with the account name, so path-style emulator URLs repeat it.
+func storageSharedKeyStringToSign(request *http.Request, accountName string) string {
+	contentLength := ""
+	if request.ContentLength > 0 {
+		contentLength = strconv.FormatInt(request.ContentLength, 10)
+	}
+
+	var msHeaders []string
+	for name := range request.Header {
+		lowerName := strings.ToLower(name)
+		if strings.HasPrefix(lowerName, "x-ms-") {
+			msHeaders = append(msHeaders, lowerName)
+		}
+	}
+	sort.Strings(msHeaders)
+
+	var canonicalizedHeaders strings.Builder
+	for _, name := range msHeaders {
+		canonicalizedHeaders.WriteString(name + ":" + strings.TrimSpace(request.Header.Get(name)) + "\n")
+	}
+
+	var canonicalizedResource strings.Builder
+	canonicalizedResource.WriteString("/" + accountName + request.URL.EscapedPath())
+
+	query := request.URL.Query()
+	queryNames := make([]string, 0, len(query))
+	for name := range query {
+		queryNames = append(queryNames, name)
+	}
+	sort.Strings(queryNames)
+
+	for _, name := range queryNames {
+		values := query[name]
+		sort.Strings(values)
+		canonicalizedResource.WriteString("\n" + strings.ToLower(name) + ":" + strings.Join(values, ","))
+	}
+
+	return strings.Join([]string{
+		request.Method,
+		request.Header.Get("Content-Encoding"),
+		request.Header.Get("Content-Language"),
+		contentLength,
+		request.Header.Get("Content-MD5"),
+		request.Header.Get("Content-Type"),
+		"",
+		request.Header.Get("If-Modified-Since"),
+		request.Header.Get("If-Match"),
+		request.Header.Get("If-None-Match"),
+		request.Header.Get("If-Unmodified-Since"),
+		request.Header.Get("Range"),
+		canonicalizedHeaders.String() + canonicalizedResource.String(),
+	}, "\n")
+}
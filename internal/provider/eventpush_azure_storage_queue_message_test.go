@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestAccEventPushAzureStorageQueueMessage_Simple(t *testing.T) {
+	config1 := `
+provider "eventpush" {
+  azure {
+    storage_account_name   = "devstoreaccount1"
+    storage_account_key    = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+    storage_queue_endpoint = "http://127.0.0.1:10001/devstoreaccount1"
+  }
+}
+
+resource "eventpush_azure_storage_queue_message" "test" {
+  queue_name   = "eventpush-test"
+  message_body = "test message 1"
+}
+`
+
+	config2 := `
+provider "eventpush" {
+  azure {
+    storage_account_name   = "devstoreaccount1"
+    storage_account_key    = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+    storage_queue_endpoint = "http://127.0.0.1:10001/devstoreaccount1"
+  }
+}
+
+resource "eventpush_azure_storage_queue_message" "test" {
+  queue_name   = "eventpush-test"
+  message_body = "test message 2"
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_azure_storage_queue_message.test", "queue_name", "eventpush-test"),
+					resource.TestCheckResourceAttr("eventpush_azure_storage_queue_message.test", "message_body", "test message 1"),
+					resource.TestCheckResourceAttrSet("eventpush_azure_storage_queue_message.test", "message_id"),
+					resource.TestCheckResourceAttrSet("eventpush_azure_storage_queue_message.test", "expiration_time"),
+				),
+			},
+			{
+				RefreshState: true,
+			},
+			{
+				Config: config2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_azure_storage_queue_message.test", "queue_name", "eventpush-test"),
+					resource.TestCheckResourceAttr("eventpush_azure_storage_queue_message.test", "message_body", "test message 2"),
+					resource.TestCheckResourceAttrSet("eventpush_azure_storage_queue_message.test", "message_id"),
+					resource.TestCheckResourceAttrSet("eventpush_azure_storage_queue_message.test", "expiration_time"),
+				),
+			},
+			{
+				Config:  config2,
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func TestAccEventPushAzureStorageQueueMessage_WithoutEnvelope(t *testing.T) {
+	config1 := `
+resource "eventpush_azure_storage_queue_message" "test" {
+  queue_name   = "eventpush-test"
+  envelope     = false
+  message_body = "test message 1"
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config1,
+				ExpectError: regexp.MustCompile("Missing envelope"),
+			},
+		},
+	})
+}
+
+func TestStorageSharedKeyStringToSign(t *testing.T) {
+	cases := []struct {
+		name        string
+		method      string
+		url         string
+		body        string
+		accountName string
+		headers     map[string]string
+		expected    string
+	}{
+		{
+			// The Put Blob example from the Authorize with Shared Key documentation
+			name:        "documented",
+			method:      http.MethodPut,
+			url:         "https://testaccount1.blob.core.windows.net/mycontainer/hello.txt",
+			body:        "hello world",
+			accountName: "testaccount1",
+			headers: map[string]string{
+				"Content-Type": "text/plain; charset=UTF-8",
+				"x-ms-date":    "Sun, 20 Sep 2009 20:36:40 GMT",
+				"x-ms-meta-m1": "v1",
+				"x-ms-meta-m2": "v2",
+			},
+			expected: "PUT\n\n\n11\n\ntext/plain; charset=UTF-8\n\n\n\n\n\n\nx-ms-date:Sun, 20 Sep 2009 20:36:40 GMT\nx-ms-meta-m1:v1\nx-ms-meta-m2:v2\n/testaccount1/mycontainer/hello.txt",
+		},
+		{
+			name:        "query",
+			method:      http.MethodPost,
+			url:         "https://myaccount.queue.core.windows.net/eventpush-test/messages?visibilitytimeout=0&messagettl=-1",
+			accountName: "myaccount",
+			headers: map[string]string{
+				"x-ms-date":    "Fri, 16 Oct 2026 12:00:00 GMT",
+				"x-ms-version": "2021-12-02",
+			},
+			expected: "POST\n\n\n\n\n\n\n\n\n\n\n\nx-ms-date:Fri, 16 Oct 2026 12:00:00 GMT\nx-ms-version:2021-12-02\n/myaccount/eventpush-test/messages\nmessagettl:-1\nvisibilitytimeout:0",
+		},
+		{
+			// Azurite uses path-style URLs, so the account name appears twice in the canonicalized resource
+			name:        "azurite",
+			method:      http.MethodPost,
+			url:         "http://127.0.0.1:10001/devstoreaccount1/eventpush-test/messages?visibilitytimeout=0&messagettl=-1",
+			body:        "<QueueMessage><MessageText>dGVzdA==</MessageText></QueueMessage>",
+			accountName: "devstoreaccount1",
+			headers: map[string]string{
+				"Content-Type": "application/xml",
+				"x-ms-date":    "Fri, 16 Oct 2026 12:00:00 GMT",
+				"x-ms-version": "2021-12-02",
+			},
+			expected: "POST\n\n\n64\n\napplication/xml\n\n\n\n\n\n\nx-ms-date:Fri, 16 Oct 2026 12:00:00 GMT\nx-ms-version:2021-12-02\n/devstoreaccount1/devstoreaccount1/eventpush-test/messages\nmessagettl:-1\nvisibilitytimeout:0",
+		},
+	}
+
+	for _, c := range cases {
+		request, err := http.NewRequest(c.method, c.url, strings.NewReader(c.body))
+		if err != nil {
+			t.Fatalf("%s: unable to create request: %s", c.name, err)
+		}
+		for name, value := range c.headers {
+			request.Header.Set(name, value)
+		}
+
+		actual := storageSharedKeyStringToSign(request, c.accountName)
+		if actual != c.expected {
+			t.Errorf("%s: string to sign = %q, expected %q", c.name, actual, c.expected)
+		}
+	}
+}
+
+func TestSignStorageSharedKeyRequest(t *testing.T) {
+	// The well-known Azurite account key
+	accountKey := "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+
+	request, err := http.NewRequest(http.MethodPost, "http://127.0.0.1:10001/devstoreaccount1/eventpush-test/messages?visibilitytimeout=0&messagettl=-1", strings.NewReader("<QueueMessage><MessageText>dGVzdA==</MessageText></QueueMessage>"))
+	if err != nil {
+		t.Fatalf("unable to create request: %s", err)
+	}
+	request.Header.Set("Content-Type", "application/xml")
+	request.Header.Set("x-ms-date", "Fri, 16 Oct 2026 12:00:00 GMT")
+	request.Header.Set("x-ms-version", "2021-12-02")
+
+	authorization, err := signStorageSharedKeyRequest(request, "devstoreaccount1", accountKey)
+	if err != nil {
+		t.Fatalf("signStorageSharedKeyRequest returned error: %s", err)
+	}
+
+	expected := "SharedKey devstoreaccount1:T4Q8wIkPFz6vTepXruy6WkrkwqGLD41M3SfxElphTM4="
+	if authorization != expected {
+		t.Errorf("signStorageSharedKeyRequest = %q, expected %q", authorization, expected)
+	}
+
+	if _, err := signStorageSharedKeyRequest(request, "", accountKey); err == nil {
+		t.Errorf("signStorageSharedKeyRequest without an account name expected an error")
+	}
+	if _, err := signStorageSharedKeyRequest(request, "devstoreaccount1", "not base64"); err == nil {
+		t.Errorf("signStorageSharedKeyRequest with an invalid account key expected an error")
+	}
+}
@@ -0,0 +1,306 @@
+package provider
+
+import (
+	"cloud.google.com/go/pubsub"
+	"context"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/api/option"
+	"os"
+	"strings"
+)
+
+var _ resource.Resource = &GCPPubSubPublishResource{}
+var _ resource.ResourceWithConfigure = &GCPPubSubPublishResource{}
+
+type GCPPubSubPublishResource struct {
+	AWSClient        *AWSClient
+	GCPConfigOptions GCPConfigOptions
+}
+
+type GCPPubSubPublishResourceModel struct {
+	Attributes   types.Map                    `tfsdk:"attributes"`
+	CreateOnly   types.Bool                   `tfsdk:"create_only"`
+	Data         types.String                 `tfsdk:"data"`
+	EventId      types.String                 `tfsdk:"event_id"`
+	KMSSignature []KMSSignatureAttributeModel `tfsdk:"kms_signature"`
+	MD5OfData    types.String                 `tfsdk:"md5_of_data"`
+	MessageId    types.String                 `tfsdk:"message_id"`
+	OrderingKey  types.String                 `tfsdk:"ordering_key"`
+	Topic        types.String                 `tfsdk:"topic"`
+}
+
+func newGCPPubSubPublishResource() resource.Resource {
+	return &GCPPubSubPublishResource{}
+}
+
+func (r *GCPPubSubPublishResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerMeta := request.ProviderData.(Meta)
+
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("unable to load SDK config", err.Error())
+		return
+	}
+
+	if providerMeta.AWSConfigOptions.Region != "" {
+		cfg.Region = providerMeta.AWSConfigOptions.Region
+	}
+
+	kmsClient := kms.NewFromConfig(cfg)
+
+	r.AWSClient = &AWSClient{
+		KMSClient: kmsClient,
+		Region:    providerMeta.AWSConfigOptions.Region,
+	}
+	r.GCPConfigOptions = providerMeta.GCPConfigOptions
+}
+
+func (r *GCPPubSubPublishResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_gcp_pubsub_publish"
+}
+
+func (r *GCPPubSubPublishResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Publish a message to a Google Cloud Pub/Sub topic. The `PUBSUB_EMULATOR_HOST` environment variable is honored to publish to a local emulator.",
+		Attributes: map[string]schema.Attribute{
+			"attributes": schema.MapAttribute{
+				Description: "Attributes to send with the message.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"create_only": schema.BoolAttribute{
+				Description: "When enabled, forces resource to be replaced on update.",
+				Optional:    true,
+			},
+			"data": schema.StringAttribute{
+				Description: "The message data.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(replaceIfCreateOnlySet, "Forces replacement of resource.", "Forces replacement of resource."),
+				},
+			},
+			"event_id": schema.StringAttribute{
+				Description: "Generated ID for resource tracking.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"md5_of_data": schema.StringAttribute{
+				Description: "The MD5 of the message data.",
+				Computed:    true,
+			},
+			"message_id": schema.StringAttribute{
+				Description: "The server-assigned ID of the last message published.",
+				Computed:    true,
+			},
+			"ordering_key": schema.StringAttribute{
+				Description: "Messages with the same ordering key are delivered in order to subscriptions with message ordering enabled.",
+				Optional:    true,
+			},
+			"topic": schema.StringAttribute{
+				Description: "The topic ID, or the full topic name in the form `projects/{project}/topics/{topic}`.",
+				Required:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"kms_signature": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"kms_key_id": schema.StringAttribute{
+							Description: "The ID of the AWS KMS key.",
+							Required:    true,
+						},
+						"message_attribute": schema.StringAttribute{
+							Description: "Message attribute name to add signature value.",
+							Optional:    true,
+						},
+						"algorithm": schema.StringAttribute{
+							Description: "The KMS signature algorithm.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOfCaseInsensitive(
+									[]string{
+										string(kmstypes.SigningAlgorithmSpecRsassaPkcs1V15Sha256),
+									}...,
+								),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *GCPPubSubPublishResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data GCPPubSubPublishResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	err := publishPubSubMessage(ctx, r, &data, "create")
+	if err != nil {
+		response.Diagnostics.AddError("Error publishing message to Pub/Sub topic.", err.Error())
+		return
+	}
+
+	data.EventId = types.StringValue(uuid.New().String())
+	data.MD5OfData = types.StringValue(createMD5OfMessageBody(data.Data.ValueString()))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *GCPPubSubPublishResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data GCPPubSubPublishResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *GCPPubSubPublishResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state GCPPubSubPublishResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	planDataMD5 := createMD5OfMessageBody(plan.Data.ValueString())
+	stateDataMD5 := createMD5OfMessageBody(state.Data.ValueString())
+
+	if planDataMD5 != stateDataMD5 {
+		err := publishPubSubMessage(ctx, r, &plan, "update")
+		if err != nil {
+			response.Diagnostics.AddError("Error publishing message to Pub/Sub topic.", err.Error())
+			return
+		}
+	} else {
+		plan.MessageId = state.MessageId
+	}
+	plan.MD5OfData = types.StringValue(planDataMD5)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *GCPPubSubPublishResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data GCPPubSubPublishResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.CreateOnly.ValueBool() {
+		err := publishPubSubMessage(ctx, r, &data, "delete")
+		if err != nil {
+			response.Diagnostics.AddError("Error publishing message to Pub/Sub topic.", err.Error())
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func publishPubSubMessage(ctx context.Context, r *GCPPubSubPublishResource, data *GCPPubSubPublishResourceModel, lifeCycle string) error {
+	attributes := make(map[string]string)
+	message := &pubsub.Message{
+		Data:        []byte(data.Data.ValueString()),
+		OrderingKey: data.OrderingKey.ValueString(),
+	}
+
+	if !data.Attributes.IsNull() {
+		diags := data.Attributes.ElementsAs(ctx, &attributes, false)
+		if diags.HasError() {
+			return fmt.Errorf("unable to read attributes")
+		}
+	}
+
+	if data.KMSSignature != nil {
+		attrName, signature, err := signMessageWithKMSSignatureBlock(ctx, r.AWSClient.KMSClient, data.KMSSignature[0], data.Data.ValueString())
+		if err != nil {
+			return err
+		}
+		attributes[attrName] = signature
+	}
+
+	attributes["X-LifeCycle-Event"] = lifeCycle
+	message.Attributes = attributes
+
+	project := r.GCPConfigOptions.Project
+	topicId := data.Topic.ValueString()
+
+	// Full topic names carry their own project
+	if strings.HasPrefix(topicId, "projects/") {
+		parts := strings.Split(topicId, "/")
+		if len(parts) != 4 || parts[2] != "topics" {
+			return fmt.Errorf("topic %q is not in the form projects/{project}/topics/{topic}", topicId)
+		}
+		project = parts[1]
+		topicId = parts[3]
+	}
+
+	if project == "" {
+		project = pubsub.DetectProjectID
+	}
+
+	client, err := newPubSubClient(ctx, r.GCPConfigOptions, project)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	topic := client.Topic(topicId)
+	topic.EnableMessageOrdering = message.OrderingKey != ""
+	defer topic.Stop()
+
+	messageId, err := topic.Publish(ctx, message).Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	data.MessageId = types.StringValue(messageId)
+
+	return nil
+}
+
+func newPubSubClient(ctx context.Context, options GCPConfigOptions, project string) (*pubsub.Client, error) {
+	var opts []option.ClientOption
+
+	// The client connects to the emulator without authentication, so credentials would conflict
+	if options.Credentials != "" && os.Getenv("PUBSUB_EMULATOR_HOST") == "" {
+		opts = append(opts, option.WithCredentialsJSON([]byte(options.Credentials)))
+	}
+
+	return pubsub.NewClient(ctx, project, opts...)
+}
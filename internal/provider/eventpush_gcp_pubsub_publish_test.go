@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccEventPushGCPPubSubPublish_Simple(t *testing.T) {
+	config1 := `
+provider "eventpush" {
+  gcp {
+    project = "eventpush-test"
+  }
+}
+
+resource "eventpush_gcp_pubsub_publish" "test" {
+  topic = "eventpush-test"
+  data  = "test message 1"
+}
+`
+
+	config2 := `
+provider "eventpush" {
+  gcp {
+    project = "eventpush-test"
+  }
+}
+
+resource "eventpush_gcp_pubsub_publish" "test" {
+  topic = "eventpush-test"
+  data  = "test message 2"
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_gcp_pubsub_publish.test", "topic", "eventpush-test"),
+					resource.TestCheckResourceAttr("eventpush_gcp_pubsub_publish.test", "data", "test message 1"),
+					resource.TestCheckResourceAttrSet("eventpush_gcp_pubsub_publish.test", "event_id"),
+					resource.TestCheckResourceAttrSet("eventpush_gcp_pubsub_publish.test", "message_id"),
+				),
+			},
+			{
+				RefreshState: true,
+			},
+			{
+				Config: config2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_gcp_pubsub_publish.test", "topic", "eventpush-test"),
+					resource.TestCheckResourceAttr("eventpush_gcp_pubsub_publish.test", "data", "test message 2"),
+					resource.TestCheckResourceAttrSet("eventpush_gcp_pubsub_publish.test", "event_id"),
+					resource.TestCheckResourceAttrSet("eventpush_gcp_pubsub_publish.test", "message_id"),
+				),
+			},
+			{
+				Config:  config2,
+				Destroy: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,579 @@
+package provider
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// httpRequestDeleteSignatureKey is the private state key holding the HMAC signature of the delete
+// request, which is computed while the write-only secret is still available.
+const httpRequestDeleteSignatureKey = "delete_signature"
+
+const httpRequestMaxResponseBody = 1 << 20
+
+var _ resource.Resource = &HTTPRequestResource{}
+var _ resource.ResourceWithConfigure = &HTTPRequestResource{}
+
+type HTTPRequestResource struct {
+	AWSClient *AWSClient
+}
+
+type HTTPRequestResourceModel struct {
+	Body                types.String                 `tfsdk:"body"`
+	Create              []HTTPRequestPhaseModel      `tfsdk:"create"`
+	CreateOnly          types.Bool                   `tfsdk:"create_only"`
+	Delete              []HTTPRequestPhaseModel      `tfsdk:"delete"`
+	EventId             types.String                 `tfsdk:"event_id"`
+	ExpectedStatusCodes types.List                   `tfsdk:"expected_status_codes"`
+	Headers             types.Map                    `tfsdk:"headers"`
+	HMACSecret          types.String                 `tfsdk:"hmac_secret_wo"`
+	KMSSignature        []KMSSignatureAttributeModel `tfsdk:"kms_signature"`
+	MaxRetries          types.Int64                  `tfsdk:"max_retries"`
+	Method              types.String                 `tfsdk:"method"`
+	ResponseBody        types.String                 `tfsdk:"response_body"`
+	ResponseHeaders     types.Map                    `tfsdk:"response_headers"`
+	ResponseStatusCode  types.Int64                  `tfsdk:"response_status_code"`
+	SignatureHeader     types.String                 `tfsdk:"signature_header"`
+	Update              []HTTPRequestPhaseModel      `tfsdk:"update"`
+	URL                 types.String                 `tfsdk:"url"`
+}
+
+type HTTPRequestPhaseModel struct {
+	Body    types.String `tfsdk:"body"`
+	Headers types.Map    `tfsdk:"headers"`
+	Method  types.String `tfsdk:"method"`
+	URL     types.String `tfsdk:"url"`
+}
+
+type httpRequestDefinition struct {
+	Body    string
+	Headers map[string]string
+	Method  string
+	URL     string
+}
+
+func newHTTPRequestResource() resource.Resource {
+	return &HTTPRequestResource{}
+}
+
+func (r *HTTPRequestResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerMeta := request.ProviderData.(Meta)
+
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("unable to load SDK config", err.Error())
+		return
+	}
+
+	if providerMeta.AWSConfigOptions.Region != "" {
+		cfg.Region = providerMeta.AWSConfigOptions.Region
+	}
+
+	kmsClient := kms.NewFromConfig(cfg)
+
+	r.AWSClient = &AWSClient{
+		KMSClient: kmsClient,
+		Region:    providerMeta.AWSConfigOptions.Region,
+	}
+}
+
+func (r *HTTPRequestResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_http_request"
+}
+
+func (r *HTTPRequestResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	phaseBlock := func(lifeCycle string) schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			Description: fmt.Sprintf("Overrides the request sent for the %s event.", lifeCycle),
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"body": schema.StringAttribute{
+						Description: "The request body.",
+						Optional:    true,
+					},
+					"headers": schema.MapAttribute{
+						Description: "Headers to send with the request, merged over the top level headers.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"method": schema.StringAttribute{
+						Description: "The HTTP method.",
+						Optional:    true,
+					},
+					"url": schema.StringAttribute{
+						Description: "The request URL.",
+						Optional:    true,
+					},
+				},
+			},
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Send an HTTP request to a webhook endpoint. The request can differ per lifecycle event, is retried with backoff on 5xx and 429 responses, and can be signed with an HMAC-SHA256 signature.",
+		Attributes: map[string]schema.Attribute{
+			"body": schema.StringAttribute{
+				Description: "The request body.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(replaceIfCreateOnlySet, "Forces replacement of resource.", "Forces replacement of resource."),
+				},
+			},
+			"create_only": schema.BoolAttribute{
+				Description: "When enabled, forces resource to be replaced on update.",
+				Optional:    true,
+			},
+			"event_id": schema.StringAttribute{
+				Description: "Generated ID for resource tracking.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expected_status_codes": schema.ListAttribute{
+				Description: "The response status codes that are treated as success. Defaults to any 2xx status code.",
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueInt64sAre(int64validator.Between(100, 599)),
+				},
+			},
+			"headers": schema.MapAttribute{
+				Description: "Headers to send with the request.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"hmac_secret_wo": schema.StringAttribute{
+				Description: "The secret used to sign the request body with HMAC-SHA256. The secret is write-only and is never stored in state; the signature of the delete request is computed ahead of time.",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "How many times a request is retried after a 5xx or 429 response, with exponential backoff. Defaults to 3.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 10),
+				},
+			},
+			"method": schema.StringAttribute{
+				Description: "The HTTP method. Defaults to `POST`.",
+				Optional:    true,
+			},
+			"response_body": schema.StringAttribute{
+				Description: "The body of the last response, up to 1 MiB.",
+				Computed:    true,
+			},
+			"response_headers": schema.MapAttribute{
+				Description: "The headers of the last response. Repeated headers are joined with a comma.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"response_status_code": schema.Int64Attribute{
+				Description: "The status code of the last response.",
+				Computed:    true,
+			},
+			"signature_header": schema.StringAttribute{
+				Description: "The header the HMAC signature is sent in, formatted as `sha256=<hex digest>`. Defaults to `X-Signature-256`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("hmac_secret_wo")),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: "The request URL.",
+				Required:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"create": phaseBlock("create"),
+			"delete": phaseBlock("delete"),
+			"kms_signature": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"kms_key_id": schema.StringAttribute{
+							Description: "The ID of the AWS KMS key.",
+							Required:    true,
+						},
+						"message_attribute": schema.StringAttribute{
+							Description: "Header name to add signature value.",
+							Optional:    true,
+						},
+						"algorithm": schema.StringAttribute{
+							Description: "The KMS signature algorithm.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOfCaseInsensitive(
+									[]string{
+										string(kmstypes.SigningAlgorithmSpecRsassaPkcs1V15Sha256),
+									}...,
+								),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+			"update": phaseBlock("update"),
+		},
+	}
+}
+
+func (r *HTTPRequestResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data HTTPRequestResourceModel
+	var hmacSecret types.String
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("hmac_secret_wo"), &hmacSecret)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.EventId = types.StringValue(uuid.New().String())
+
+	err := sendHTTPRequest(ctx, r, &data, "create", hmacSignatureFunc(hmacSecret))
+	if err != nil {
+		response.Diagnostics.AddError("Error sending HTTP request.", err.Error())
+		return
+	}
+
+	deleteSignature, err := createHTTPRequestDeleteSignature(ctx, &data, hmacSecret)
+	if err != nil {
+		response.Diagnostics.AddError("Error signing HTTP delete request.", err.Error())
+		return
+	}
+	response.Diagnostics.Append(response.Private.SetKey(ctx, httpRequestDeleteSignatureKey, deleteSignature)...)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *HTTPRequestResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data HTTPRequestResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *HTTPRequestResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state HTTPRequestResourceModel
+	var hmacSecret types.String
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("hmac_secret_wo"), &hmacSecret)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	planRequest, err := httpRequestForLifeCycle(ctx, &plan, "update")
+	if err != nil {
+		response.Diagnostics.AddError("Error reading HTTP request.", err.Error())
+		return
+	}
+
+	stateRequest, err := httpRequestForLifeCycle(ctx, &state, "update")
+	if err != nil {
+		response.Diagnostics.AddError("Error reading HTTP request.", err.Error())
+		return
+	}
+
+	// Only a change to the update request itself sends it again, and never when create_only is set
+	if !plan.CreateOnly.ValueBool() && !httpRequestDefinitionsEqual(planRequest, stateRequest) {
+		err := sendHTTPRequest(ctx, r, &plan, "update", hmacSignatureFunc(hmacSecret))
+		if err != nil {
+			response.Diagnostics.AddError("Error sending HTTP request.", err.Error())
+			return
+		}
+	} else {
+		plan.ResponseBody = state.ResponseBody
+		plan.ResponseHeaders = state.ResponseHeaders
+		plan.ResponseStatusCode = state.ResponseStatusCode
+	}
+
+	deleteSignature, err := createHTTPRequestDeleteSignature(ctx, &plan, hmacSecret)
+	if err != nil {
+		response.Diagnostics.AddError("Error signing HTTP delete request.", err.Error())
+		return
+	}
+	response.Diagnostics.Append(response.Private.SetKey(ctx, httpRequestDeleteSignatureKey, deleteSignature)...)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *HTTPRequestResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data HTTPRequestResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.CreateOnly.ValueBool() {
+		deleteSignatureJSON, diags := request.Private.GetKey(ctx, httpRequestDeleteSignatureKey)
+		response.Diagnostics.Append(diags...)
+
+		var deleteSignature string
+		if deleteSignatureJSON != nil {
+			err := json.Unmarshal(deleteSignatureJSON, &deleteSignature)
+			if err != nil {
+				response.Diagnostics.AddError("Error reading HTTP delete request signature.", err.Error())
+				return
+			}
+		}
+
+		signatureFunc := func(string) string {
+			return deleteSignature
+		}
+
+		err := sendHTTPRequest(ctx, r, &data, "delete", signatureFunc)
+		if err != nil {
+			response.Diagnostics.AddError("Error sending HTTP request.", err.Error())
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// hmacSignatureFunc returns a function signing a request body with the secret, or returning an
+// empty signature when no secret is set.
+func hmacSignatureFunc(secret types.String) func(string) string {
+	return func(body string) string {
+		if secret.IsNull() || secret.IsUnknown() {
+			return ""
+		}
+
+		mac := hmac.New(sha256.New, []byte(secret.ValueString()))
+		mac.Write([]byte(body))
+		return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	}
+}
+
+func createHTTPRequestDeleteSignature(ctx context.Context, data *HTTPRequestResourceModel, secret types.String) ([]byte, error) {
+	deleteRequest, err := httpRequestForLifeCycle(ctx, data, "delete")
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(hmacSignatureFunc(secret)(deleteRequest.Body))
+}
+
+// httpRequestForLifeCycle builds the request for a lifecycle event from the top level attributes
+// and the block of the lifecycle event.
+func httpRequestForLifeCycle(ctx context.Context, data *HTTPRequestResourceModel, lifeCycle string) (httpRequestDefinition, error) {
+	definition := httpRequestDefinition{
+		Body:    data.Body.ValueString(),
+		Headers: make(map[string]string),
+		Method:  http.MethodPost,
+		URL:     data.URL.ValueString(),
+	}
+
+	if !data.Method.IsNull() {
+		definition.Method = strings.ToUpper(data.Method.ValueString())
+	}
+
+	if !data.Headers.IsNull() {
+		diags := data.Headers.ElementsAs(ctx, &definition.Headers, false)
+		if diags.HasError() {
+			return definition, fmt.Errorf("unable to read headers")
+		}
+	}
+
+	var phases []HTTPRequestPhaseModel
+	switch lifeCycle {
+	case "create":
+		phases = data.Create
+	case "update":
+		phases = data.Update
+	case "delete":
+		phases = data.Delete
+	}
+
+	if len(phases) == 0 {
+		return definition, nil
+	}
+
+	phase := phases[0]
+
+	if !phase.Body.IsNull() {
+		definition.Body = phase.Body.ValueString()
+	}
+
+	if !phase.Method.IsNull() {
+		definition.Method = strings.ToUpper(phase.Method.ValueString())
+	}
+
+	if !phase.URL.IsNull() {
+		definition.URL = phase.URL.ValueString()
+	}
+
+	if !phase.Headers.IsNull() {
+		phaseHeaders := make(map[string]string)
+		diags := phase.Headers.ElementsAs(ctx, &phaseHeaders, false)
+		if diags.HasError() {
+			return definition, fmt.Errorf("unable to read %s headers", lifeCycle)
+		}
+		maps.Copy(definition.Headers, phaseHeaders)
+	}
+
+	return definition, nil
+}
+
+func httpRequestDefinitionsEqual(a, b httpRequestDefinition) bool {
+	return a.Body == b.Body && a.Method == b.Method && a.URL == b.URL && maps.Equal(a.Headers, b.Headers)
+}
+
+func sendHTTPRequest(ctx context.Context, r *HTTPRequestResource, data *HTTPRequestResourceModel, lifeCycle string, signatureFunc func(string) string) error {
+	definition, err := httpRequestForLifeCycle(ctx, data, lifeCycle)
+	if err != nil {
+		return err
+	}
+
+	headers := definition.Headers
+
+	if data.KMSSignature != nil {
+		attrName, signature, err := signMessageWithKMSSignatureBlock(ctx, r.AWSClient.KMSClient, data.KMSSignature[0], definition.Body)
+		if err != nil {
+			return err
+		}
+		headers[attrName] = signature
+	}
+
+	if signature := signatureFunc(definition.Body); signature != "" {
+		signatureHeader := "X-Signature-256"
+		if !data.SignatureHeader.IsNull() {
+			signatureHeader = data.SignatureHeader.ValueString()
+		}
+		headers[signatureHeader] = signature
+	}
+
+	headers["X-LifeCycle-Event"] = lifeCycle
+
+	var expectedStatusCodes []int64
+	if !data.ExpectedStatusCodes.IsNull() {
+		diags := data.ExpectedStatusCodes.ElementsAs(ctx, &expectedStatusCodes, false)
+		if diags.HasError() {
+			return fmt.Errorf("unable to read expected status codes")
+		}
+	}
+
+	maxRetries := int64(3)
+	if !data.MaxRetries.IsNull() {
+		maxRetries = data.MaxRetries.ValueInt64()
+	}
+
+	httpClient := &http.Client{Timeout: 30 * time.Second}
+
+	var httpResponse *http.Response
+	var responseBody []byte
+	for attempt := int64(0); ; attempt++ {
+		httpRequest, err := http.NewRequestWithContext(ctx, definition.Method, definition.URL, strings.NewReader(definition.Body))
+		if err != nil {
+			return err
+		}
+
+		for key, value := range headers {
+			httpRequest.Header.Set(key, value)
+		}
+
+		httpResponse, err = httpClient.Do(httpRequest)
+		if err != nil {
+			return err
+		}
+
+		responseBody, err = io.ReadAll(io.LimitReader(httpResponse.Body, httpRequestMaxResponseBody))
+		httpResponse.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		retryable := httpResponse.StatusCode >= 500 || httpResponse.StatusCode == http.StatusTooManyRequests
+		if !retryable || attempt >= maxRetries {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(httpRequestRetryDelay(httpResponse, attempt)):
+		}
+	}
+
+	responseHeaders := make(map[string]string)
+	for key, values := range httpResponse.Header {
+		responseHeaders[key] = strings.Join(values, ", ")
+	}
+
+	responseHeadersValue, diags := types.MapValueFrom(ctx, types.StringType, responseHeaders)
+	if diags.HasError() {
+		return fmt.Errorf("unable to store response headers")
+	}
+
+	data.ResponseBody = types.StringValue(string(responseBody))
+	data.ResponseHeaders = responseHeadersValue
+	data.ResponseStatusCode = types.Int64Value(int64(httpResponse.StatusCode))
+
+	statusCode := int64(httpResponse.StatusCode)
+	if expectedStatusCodes == nil {
+		if statusCode < 200 || statusCode > 299 {
+			return fmt.Errorf("unexpected response status %s: %s", httpResponse.Status, responseBody)
+		}
+	} else if !slices.Contains(expectedStatusCodes, statusCode) {
+		return fmt.Errorf("unexpected response status %s: %s", httpResponse.Status, responseBody)
+	}
+
+	return nil
+}
+
+// httpRequestRetryDelay honors a Retry-After header in seconds, otherwise backs off exponentially
+// from one second up to 30 seconds.
+func httpRequestRetryDelay(httpResponse *http.Response, attempt int64) time.Duration {
+	if retryAfter, err := strconv.Atoi(httpResponse.Header.Get("Retry-After")); err == nil && retryAfter >= 0 {
+		return min(time.Duration(retryAfter)*time.Second, 30*time.Second)
+	}
+
+	return min(time.Second<<attempt, 30*time.Second)
+}
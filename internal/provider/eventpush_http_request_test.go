@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestAccEventPushHTTPRequest_Simple(t *testing.T) {
+	config1 := `
+resource "eventpush_http_request" "test" {
+  url  = "http://localhost:8080/eventpush"
+  body = "test message 1"
+
+  delete {
+    method = "DELETE"
+  }
+}
+`
+
+	config2 := `
+resource "eventpush_http_request" "test" {
+  url  = "http://localhost:8080/eventpush"
+  body = "test message 1"
+
+  update {
+    body = "test message 2"
+  }
+
+  delete {
+    method = "DELETE"
+  }
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_http_request.test", "url", "http://localhost:8080/eventpush"),
+					resource.TestCheckResourceAttr("eventpush_http_request.test", "body", "test message 1"),
+					resource.TestCheckResourceAttrSet("eventpush_http_request.test", "event_id"),
+					resource.TestCheckResourceAttrSet("eventpush_http_request.test", "response_status_code"),
+				),
+			},
+			{
+				RefreshState: true,
+			},
+			{
+				Config: config2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_http_request.test", "url", "http://localhost:8080/eventpush"),
+					resource.TestCheckResourceAttr("eventpush_http_request.test", "update.0.body", "test message 2"),
+					resource.TestCheckResourceAttrSet("eventpush_http_request.test", "response_status_code"),
+				),
+			},
+			{
+				Config:  config2,
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func TestHMACSignatureFunc(t *testing.T) {
+	cases := []struct {
+		secret   types.String
+		body     string
+		expected string
+	}{
+		{secret: types.StringValue("secret"), body: "test message 1", expected: "sha256=e33a32ae6181ab15f16c22eba48dad94d5ceca3d29c0a5c696114df51549e9a8"},
+		{secret: types.StringValue("secret"), body: "", expected: "sha256=f9e66e179b6747ae54108f82f8ade8b3c25d76fd30afde6c395822c530196169"},
+		{secret: types.StringNull(), body: "test message 1", expected: ""},
+		{secret: types.StringUnknown(), body: "test message 1", expected: ""},
+	}
+
+	for _, c := range cases {
+		actual := hmacSignatureFunc(c.secret)(c.body)
+		if actual != c.expected {
+			t.Errorf("hmacSignatureFunc(%s)(%q) = %q, expected %q", c.secret, c.body, actual, c.expected)
+		}
+	}
+}
+
+func TestHTTPRequestRetryDelay(t *testing.T) {
+	cases := []struct {
+		retryAfter string
+		attempt    int64
+		expected   time.Duration
+	}{
+		{retryAfter: "", attempt: 0, expected: time.Second},
+		{retryAfter: "", attempt: 2, expected: 4 * time.Second},
+		{retryAfter: "", attempt: 10, expected: 30 * time.Second},
+		{retryAfter: "5", attempt: 3, expected: 5 * time.Second},
+		{retryAfter: "0", attempt: 3, expected: 0},
+		{retryAfter: "120", attempt: 0, expected: 30 * time.Second},
+		{retryAfter: "-1", attempt: 1, expected: 2 * time.Second},
+		{retryAfter: "Fri, 16 Oct 2026 12:00:00 GMT", attempt: 1, expected: 2 * time.Second},
+	}
+
+	for _, c := range cases {
+		httpResponse := &http.Response{Header: http.Header{}}
+		if c.retryAfter != "" {
+			httpResponse.Header.Set("Retry-After", c.retryAfter)
+		}
+
+		actual := httpRequestRetryDelay(httpResponse, c.attempt)
+		if actual != c.expected {
+			t.Errorf("httpRequestRetryDelay(Retry-After %q, %d) = %s, expected %s", c.retryAfter, c.attempt, actual, c.expected)
+		}
+	}
+}
+
+func TestHTTPRequestForLifeCycle(t *testing.T) {
+	headers := func(values map[string]string) types.Map {
+		elements := make(map[string]attr.Value)
+		for key, value := range values {
+			elements[key] = types.StringValue(value)
+		}
+		return types.MapValueMust(types.StringType, elements)
+	}
+
+	data := &HTTPRequestResourceModel{
+		Body:    types.StringValue("test message 1"),
+		Headers: headers(map[string]string{"Content-Type": "text/plain", "X-Source": "eventpush"}),
+		Method:  types.StringNull(),
+		URL:     types.StringValue("http://localhost:8080/eventpush"),
+		Update: []HTTPRequestPhaseModel{
+			{
+				Body:    types.StringNull(),
+				Headers: headers(map[string]string{"Content-Type": "application/json"}),
+				Method:  types.StringValue("put"),
+				URL:     types.StringNull(),
+			},
+		},
+		Delete: []HTTPRequestPhaseModel{
+			{
+				Body:    types.StringValue("test message 2"),
+				Headers: types.MapNull(types.StringType),
+				Method:  types.StringValue("DELETE"),
+				URL:     types.StringValue("http://localhost:8080/eventpush/1"),
+			},
+		},
+	}
+
+	cases := []struct {
+		lifeCycle string
+		expected  httpRequestDefinition
+	}{
+		{
+			lifeCycle: "create",
+			expected: httpRequestDefinition{
+				Body:    "test message 1",
+				Headers: map[string]string{"Content-Type": "text/plain", "X-Source": "eventpush"},
+				Method:  http.MethodPost,
+				URL:     "http://localhost:8080/eventpush",
+			},
+		},
+		{
+			lifeCycle: "update",
+			expected: httpRequestDefinition{
+				Body:    "test message 1",
+				Headers: map[string]string{"Content-Type": "application/json", "X-Source": "eventpush"},
+				Method:  http.MethodPut,
+				URL:     "http://localhost:8080/eventpush",
+			},
+		},
+		{
+			lifeCycle: "delete",
+			expected: httpRequestDefinition{
+				Body:    "test message 2",
+				Headers: map[string]string{"Content-Type": "text/plain", "X-Source": "eventpush"},
+				Method:  http.MethodDelete,
+				URL:     "http://localhost:8080/eventpush/1",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		actual, err := httpRequestForLifeCycle(context.Background(), data, c.lifeCycle)
+		if err != nil {
+			t.Errorf("httpRequestForLifeCycle(%s) returned error: %s", c.lifeCycle, err)
+			continue
+		}
+		if !httpRequestDefinitionsEqual(actual, c.expected) {
+			t.Errorf("httpRequestForLifeCycle(%s) = %+v, expected %+v", c.lifeCycle, actual, c.expected)
+		}
+	}
+}
@@ -0,0 +1,414 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/twmb/franz-go/pkg/kgo"
+	kafkaaws "github.com/twmb/franz-go/pkg/sasl/aws"
+	"github.com/twmb/franz-go/pkg/sasl/plain"
+	"github.com/twmb/franz-go/pkg/sasl/scram"
+	"sort"
+	"strconv"
+)
+
+var _ resource.Resource = &KafkaProduceResource{}
+var _ resource.ResourceWithConfigure = &KafkaProduceResource{}
+var _ resource.ResourceWithModifyPlan = &KafkaProduceResource{}
+
+// kafkaManualPartitionKey is the private state key recording whether the partition was set in the configuration,
+// since the delete record is produced without access to the configuration.
+const kafkaManualPartitionKey = "manual_partition"
+
+var kafkaSASLMechanisms = []string{
+	"PLAIN",
+	"SCRAM-SHA-256",
+	"SCRAM-SHA-512",
+	"AWS_MSK_IAM",
+}
+
+type KafkaProduceResource struct {
+	AWSClient          *AWSClient
+	AWSConfig          aws.Config
+	KafkaConfigOptions KafkaConfigOptions
+}
+
+type KafkaProduceResourceModel struct {
+	CreateOnly   types.Bool                   `tfsdk:"create_only"`
+	EventId      types.String                 `tfsdk:"event_id"`
+	Headers      types.Map                    `tfsdk:"headers"`
+	Key          types.String                 `tfsdk:"key"`
+	KMSSignature []KMSSignatureAttributeModel `tfsdk:"kms_signature"`
+	MD5OfValue   types.String                 `tfsdk:"md5_of_value"`
+	Offset       types.Int64                  `tfsdk:"offset"`
+	Partition    types.Int32                  `tfsdk:"partition"`
+	Topic        types.String                 `tfsdk:"topic"`
+	Value        types.String                 `tfsdk:"value"`
+}
+
+func newKafkaProduceResource() resource.Resource {
+	return &KafkaProduceResource{}
+}
+
+func (r *KafkaProduceResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerMeta := request.ProviderData.(Meta)
+
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("unable to load SDK config", err.Error())
+		return
+	}
+
+	if providerMeta.AWSConfigOptions.Region != "" {
+		cfg.Region = providerMeta.AWSConfigOptions.Region
+	}
+
+	kmsClient := kms.NewFromConfig(cfg)
+
+	r.AWSClient = &AWSClient{
+		KMSClient: kmsClient,
+		Region:    providerMeta.AWSConfigOptions.Region,
+	}
+	r.AWSConfig = cfg
+	r.KafkaConfigOptions = providerMeta.KafkaConfigOptions
+}
+
+func (r *KafkaProduceResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_kafka_produce"
+}
+
+func (r *KafkaProduceResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Produce a record to a Kafka topic.",
+		Attributes: map[string]schema.Attribute{
+			"create_only": schema.BoolAttribute{
+				Description: "When enabled, forces resource to be replaced on update.",
+				Optional:    true,
+			},
+			"event_id": schema.StringAttribute{
+				Description: "Generated ID for resource tracking.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"headers": schema.MapAttribute{
+				Description: "Headers to send with the record.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"key": schema.StringAttribute{
+				Description: "The record key, used by the default partitioner.",
+				Optional:    true,
+			},
+			"md5_of_value": schema.StringAttribute{
+				Description: "The MD5 of the record value.",
+				Computed:    true,
+			},
+			"offset": schema.Int64Attribute{
+				Description: "The offset of the last record produced.",
+				Computed:    true,
+			},
+			"partition": schema.Int32Attribute{
+				Description: "The partition to produce to. When not set, the partition is chosen from the key. Holds the partition of the last record produced.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"topic": schema.StringAttribute{
+				Description: "The name of the topic.",
+				Required:    true,
+			},
+			"value": schema.StringAttribute{
+				Description: "The record value.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(replaceIfCreateOnlySet, "Forces replacement of resource.", "Forces replacement of resource."),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"kms_signature": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"kms_key_id": schema.StringAttribute{
+							Description: "The ID of the AWS KMS key.",
+							Required:    true,
+						},
+						"message_attribute": schema.StringAttribute{
+							Description: "Record header name to add signature value.",
+							Optional:    true,
+						},
+						"algorithm": schema.StringAttribute{
+							Description: "The KMS signature algorithm.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOfCaseInsensitive(
+									[]string{
+										string(kmstypes.SigningAlgorithmSpecRsassaPkcs1V15Sha256),
+									}...,
+								),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *KafkaProduceResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, plan, state KafkaProduceResourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// A new record can land on another partition unless the partition is configured
+	if config.Partition.IsNull() && !plan.Value.Equal(state.Value) {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("partition"), types.Int32Unknown())...)
+	}
+}
+
+func (r *KafkaProduceResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data, config KafkaProduceResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.EventId = types.StringValue(uuid.New().String())
+
+	manualPartition := !config.Partition.IsNull()
+	err := produceRecord(ctx, r, &data, "create", manualPartition)
+	if err != nil {
+		response.Diagnostics.AddError("Error producing record to Kafka topic.", err.Error())
+		return
+	}
+	response.Diagnostics.Append(response.Private.SetKey(ctx, kafkaManualPartitionKey, []byte(strconv.FormatBool(manualPartition)))...)
+
+	data.MD5OfValue = types.StringValue(createMD5OfMessageBody(data.Value.ValueString()))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *KafkaProduceResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data KafkaProduceResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *KafkaProduceResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state, config KafkaProduceResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	planValueMD5 := createMD5OfMessageBody(plan.Value.ValueString())
+	stateValueMD5 := createMD5OfMessageBody(state.Value.ValueString())
+
+	manualPartition := !config.Partition.IsNull()
+	if planValueMD5 != stateValueMD5 {
+		err := produceRecord(ctx, r, &plan, "update", manualPartition)
+		if err != nil {
+			response.Diagnostics.AddError("Error producing record to Kafka topic.", err.Error())
+			return
+		}
+	} else {
+		plan.Offset = state.Offset
+	}
+	plan.MD5OfValue = types.StringValue(planValueMD5)
+	response.Diagnostics.Append(response.Private.SetKey(ctx, kafkaManualPartitionKey, []byte(strconv.FormatBool(manualPartition)))...)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *KafkaProduceResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data KafkaProduceResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.CreateOnly.ValueBool() {
+		manualPartitionJSON, diags := request.Private.GetKey(ctx, kafkaManualPartitionKey)
+		response.Diagnostics.Append(diags...)
+
+		err := produceRecord(ctx, r, &data, "delete", string(manualPartitionJSON) == "true")
+		if err != nil {
+			response.Diagnostics.AddError("Error producing record to Kafka topic.", err.Error())
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// produceRecord produces the record for a lifecycle event. The partition is only forced when it is set in the
+// configuration, otherwise the partitioner chooses it from the key.
+func produceRecord(ctx context.Context, r *KafkaProduceResource, data *KafkaProduceResourceModel, lifeCycle string, manualPartition bool) error {
+	headers := make(map[string]string)
+	record := &kgo.Record{
+		Topic: data.Topic.ValueString(),
+		Value: []byte(data.Value.ValueString()),
+	}
+
+	if !data.Key.IsNull() {
+		record.Key = []byte(data.Key.ValueString())
+	}
+
+	if !data.Headers.IsNull() {
+		diags := data.Headers.ElementsAs(ctx, &headers, false)
+		if diags.HasError() {
+			return fmt.Errorf("unable to read headers")
+		}
+	}
+
+	if data.KMSSignature != nil {
+		attrName, signature, err := signMessageWithKMSSignatureBlock(ctx, r.AWSClient.KMSClient, data.KMSSignature[0], data.Value.ValueString())
+		if err != nil {
+			return err
+		}
+		headers[attrName] = signature
+	}
+
+	headers["X-LifeCycle-Event"] = lifeCycle
+
+	// Headers are sorted so the record is the same between retries
+	keys := make([]string, 0, len(headers))
+	for key := range headers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		record.Headers = append(record.Headers, kgo.RecordHeader{Key: key, Value: []byte(headers[key])})
+	}
+
+	var opts []kgo.Opt
+	if manualPartition {
+		record.Partition = data.Partition.ValueInt32()
+		opts = append(opts, kgo.RecordPartitioner(kgo.ManualPartitioner()))
+	}
+
+	client, err := newKafkaClient(r.KafkaConfigOptions, r.AWSConfig, opts...)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	err = client.ProduceSync(ctx, record).FirstErr()
+	if err != nil {
+		return err
+	}
+
+	data.Offset = types.Int64Value(record.Offset)
+	data.Partition = types.Int32Value(record.Partition)
+
+	return nil
+}
+
+func newKafkaClient(options KafkaConfigOptions, awsConfig aws.Config, opts ...kgo.Opt) (*kgo.Client, error) {
+	if len(options.BootstrapServers) == 0 {
+		return nil, fmt.Errorf("bootstrap_servers must be set in the kafka provider block")
+	}
+
+	clientID := "eventpush"
+	if options.ClientID != "" {
+		clientID = options.ClientID
+	}
+
+	// Idempotent writes are the default, acks from all in-sync replicas are required for them
+	opts = append(opts,
+		kgo.SeedBrokers(options.BootstrapServers...),
+		kgo.ClientID(clientID),
+		kgo.RequiredAcks(kgo.AllISRAcks()),
+	)
+
+	switch options.SASLMechanism {
+	case "PLAIN":
+		opts = append(opts, kgo.SASL(plain.Auth{User: options.SASLUsername, Pass: options.SASLPassword}.AsMechanism()))
+	case "SCRAM-SHA-256":
+		opts = append(opts, kgo.SASL(scram.Auth{User: options.SASLUsername, Pass: options.SASLPassword}.AsSha256Mechanism()))
+	case "SCRAM-SHA-512":
+		opts = append(opts, kgo.SASL(scram.Auth{User: options.SASLUsername, Pass: options.SASLPassword}.AsSha512Mechanism()))
+	case "AWS_MSK_IAM":
+		opts = append(opts, kgo.SASL(kafkaaws.ManagedStreamingIAM(func(ctx context.Context) (kafkaaws.Auth, error) {
+			credentials, err := awsConfig.Credentials.Retrieve(ctx)
+			if err != nil {
+				return kafkaaws.Auth{}, err
+			}
+
+			return kafkaaws.Auth{
+				AccessKey:    credentials.AccessKeyID,
+				SecretKey:    credentials.SecretAccessKey,
+				SessionToken: credentials.SessionToken,
+			}, nil
+		})))
+	}
+
+	// MSK only accepts IAM authentication over TLS
+	tlsEnabled := options.TLSEnabled || options.SASLMechanism == "AWS_MSK_IAM" ||
+		options.TLSCACert != "" || options.TLSClientCert != "" || options.TLSInsecureSkipVerify
+
+	if tlsEnabled {
+		tlsConfig, err := newTLSConfig(options.TLSCACert, options.TLSClientCert, options.TLSClientKey, options.TLSInsecureSkipVerify)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, kgo.DialTLSConfig(tlsConfig))
+	}
+
+	return kgo.NewClient(opts...)
+}
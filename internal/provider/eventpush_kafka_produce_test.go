@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccEventPushKafkaProduce_Simple(t *testing.T) {
+	config1 := `
+provider "eventpush" {
+  kafka {
+    bootstrap_servers = ["localhost:9092"]
+  }
+}
+
+resource "eventpush_kafka_produce" "test" {
+  topic = "test-topic"
+  key   = "test-key"
+  value = "test message 1"
+
+  headers = {
+    Source = "eventpush"
+  }
+}
+`
+
+	config2 := `
+provider "eventpush" {
+  kafka {
+    bootstrap_servers = ["localhost:9092"]
+  }
+}
+
+resource "eventpush_kafka_produce" "test" {
+  topic = "test-topic"
+  key   = "test-key"
+  value = "test message 2"
+
+  headers = {
+    Source = "eventpush"
+  }
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_kafka_produce.test", "topic", "test-topic"),
+					resource.TestCheckResourceAttrSet("eventpush_kafka_produce.test", "offset"),
+				),
+			},
+			{
+				RefreshState: true,
+			},
+			{
+				Config: config2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_kafka_produce.test", "topic", "test-topic"),
+					resource.TestCheckResourceAttrSet("eventpush_kafka_produce.test", "offset"),
+				),
+			},
+			{
+				Config:  config2,
+				Destroy: true,
+			},
+		},
+	})
+}
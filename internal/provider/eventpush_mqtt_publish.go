@@ -0,0 +1,474 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/eclipse/paho.golang/packets"
+	"github.com/eclipse/paho.golang/paho"
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net"
+	"net/url"
+	"sort"
+	"time"
+)
+
+const (
+	mqttProtocolVersion311 = "3.1.1"
+	mqttProtocolVersion5   = "5"
+)
+
+var mqttProtocolVersions = []string{
+	mqttProtocolVersion311,
+	mqttProtocolVersion5,
+}
+
+var _ resource.Resource = &MQTTPublishResource{}
+var _ resource.ResourceWithConfigure = &MQTTPublishResource{}
+
+type MQTTPublishResource struct {
+	AWSClient         *AWSClient
+	MQTTConfigOptions MQTTConfigOptions
+}
+
+type MQTTPublishResourceModel struct {
+	ContentType      types.String                 `tfsdk:"content_type"`
+	CreateOnly       types.Bool                   `tfsdk:"create_only"`
+	Envelope         types.Bool                   `tfsdk:"envelope"`
+	EventId          types.String                 `tfsdk:"event_id"`
+	KMSSignature     []KMSSignatureAttributeModel `tfsdk:"kms_signature"`
+	MD5OfMessageBody types.String                 `tfsdk:"md5_of_message_body"`
+	MessageBody      types.String                 `tfsdk:"message_body"`
+	QoS              types.Int32                  `tfsdk:"qos"`
+	Retain           types.Bool                   `tfsdk:"retain"`
+	Topic            types.String                 `tfsdk:"topic"`
+	UserProperties   types.Map                    `tfsdk:"user_properties"`
+}
+
+func newMQTTPublishResource() resource.Resource {
+	return &MQTTPublishResource{}
+}
+
+func (r *MQTTPublishResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerMeta := request.ProviderData.(Meta)
+
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("unable to load SDK config", err.Error())
+		return
+	}
+
+	if providerMeta.AWSConfigOptions.Region != "" {
+		cfg.Region = providerMeta.AWSConfigOptions.Region
+	}
+
+	kmsClient := kms.NewFromConfig(cfg)
+
+	r.AWSClient = &AWSClient{
+		KMSClient: kmsClient,
+		Region:    providerMeta.AWSConfigOptions.Region,
+	}
+	r.MQTTConfigOptions = providerMeta.MQTTConfigOptions
+}
+
+func (r *MQTTPublishResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_mqtt_publish"
+}
+
+func (r *MQTTPublishResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Publish a message to an MQTT broker, such as Mosquitto or EMQX. At QoS 1 and 2 the publish waits for the broker to acknowledge the message.",
+		Attributes: map[string]schema.Attribute{
+			"content_type": schema.StringAttribute{
+				Description: "The MIME content type of the message. Requires MQTT 5.",
+				Optional:    true,
+			},
+			"create_only": schema.BoolAttribute{
+				Description: "When enabled, forces resource to be replaced on update.",
+				Optional:    true,
+			},
+			"envelope": schema.BoolAttribute{
+				Description: "When enabled, the message is sent as a JSON envelope containing the message body, the lifecycle event and the KMS signature. MQTT 3.1.1 has no user properties, so this is the only way to send the lifecycle event and signature with it, and it defaults to enabled there. Disabling it with MQTT 3.1.1 requires `create_only`.",
+				Optional:    true,
+			},
+			"event_id": schema.StringAttribute{
+				Description: "Generated ID for resource tracking.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"md5_of_message_body": schema.StringAttribute{
+				Description: "The MD5 of the message body.",
+				Computed:    true,
+			},
+			"message_body": schema.StringAttribute{
+				Description: "The message to send.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(replaceIfCreateOnlySet, "Forces replacement of resource.", "Forces replacement of resource."),
+				},
+			},
+			"qos": schema.Int32Attribute{
+				Description: "The Quality of Service (QoS) level, either 0, 1 or 2. Defaults to 0.",
+				Optional:    true,
+				Validators: []validator.Int32{
+					int32validator.Between(0, 2),
+				},
+			},
+			"retain": schema.BoolAttribute{
+				Description: "When enabled, sets the RETAIN flag so the message is sent to new subscribers of the topic.",
+				Optional:    true,
+			},
+			"topic": schema.StringAttribute{
+				Description: "The name of the MQTT topic.",
+				Required:    true,
+			},
+			"user_properties": schema.MapAttribute{
+				Description: "MQTT5 user properties to send with the message.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"kms_signature": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"kms_key_id": schema.StringAttribute{
+							Description: "The ID of the AWS KMS key.",
+							Required:    true,
+						},
+						"message_attribute": schema.StringAttribute{
+							Description: "User property name to add signature value.",
+							Optional:    true,
+						},
+						"algorithm": schema.StringAttribute{
+							Description: "The KMS signature algorithm.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOfCaseInsensitive(
+									[]string{
+										string(kmstypes.SigningAlgorithmSpecRsassaPkcs1V15Sha256),
+									}...,
+								),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *MQTTPublishResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data MQTTPublishResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	err := publishMQTTMessage(ctx, r, &data, "create")
+	if err != nil {
+		response.Diagnostics.AddError("Error publishing message to MQTT topic.", err.Error())
+		return
+	}
+
+	data.EventId = types.StringValue(uuid.New().String())
+	data.MD5OfMessageBody = types.StringValue(createMD5OfMessageBody(data.MessageBody.ValueString()))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *MQTTPublishResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data MQTTPublishResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *MQTTPublishResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state MQTTPublishResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	planMessageBodyMD5 := createMD5OfMessageBody(plan.MessageBody.ValueString())
+	stateMessageBodyMD5 := createMD5OfMessageBody(state.MessageBody.ValueString())
+
+	if planMessageBodyMD5 != stateMessageBodyMD5 {
+		err := publishMQTTMessage(ctx, r, &plan, "update")
+		if err != nil {
+			response.Diagnostics.AddError("Error publishing message to MQTT topic.", err.Error())
+			return
+		}
+	}
+	plan.MD5OfMessageBody = types.StringValue(planMessageBodyMD5)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *MQTTPublishResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data MQTTPublishResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.CreateOnly.ValueBool() {
+		err := publishMQTTMessage(ctx, r, &data, "delete")
+		if err != nil {
+			response.Diagnostics.AddError("Error publishing message to MQTT topic.", err.Error())
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func publishMQTTMessage(ctx context.Context, r *MQTTPublishResource, data *MQTTPublishResourceModel, lifeCycle string) error {
+	options := r.MQTTConfigOptions
+	if options.BrokerURL == "" {
+		return fmt.Errorf("broker_url must be set in the mqtt provider block")
+	}
+
+	payload := []byte(data.MessageBody.ValueString())
+	userProperties := make(map[string]string)
+
+	if !data.UserProperties.IsNull() {
+		diags := data.UserProperties.ElementsAs(ctx, &userProperties, false)
+		if diags.HasError() {
+			return fmt.Errorf("unable to read user properties")
+		}
+	}
+
+	// MQTT 3.1.1 has no user properties, so the envelope is the default there to carry the lifecycle event
+	useEnvelope := data.Envelope.ValueBool() || (options.ProtocolVersion == mqttProtocolVersion311 && data.Envelope.IsNull())
+
+	if useEnvelope {
+		envelope, err := createMessageEnvelope(ctx, r.AWSClient.KMSClient, data.KMSSignature, data.MessageBody.ValueString(), lifeCycle)
+		if err != nil {
+			return err
+		}
+
+		payload, err = json.Marshal(envelope)
+		if err != nil {
+			return err
+		}
+	}
+
+	if options.ProtocolVersion == mqttProtocolVersion311 {
+		if len(userProperties) > 0 || !data.ContentType.IsNull() {
+			return fmt.Errorf("user_properties and content_type require MQTT 5")
+		}
+		if data.KMSSignature != nil && !useEnvelope {
+			return fmt.Errorf("MQTT 3.1.1 has no user properties, so a KMS signature can only be sent when envelope is enabled")
+		}
+		// Without the lifecycle event a delete publish is identical to the create, and re-retains the body
+		if !useEnvelope && !data.CreateOnly.ValueBool() {
+			return fmt.Errorf("MQTT 3.1.1 has no user properties, so the lifecycle event can only be sent when envelope is enabled; enable envelope or create_only")
+		}
+
+		return publishMQTT311Message(ctx, options, data, payload)
+	}
+
+	if data.KMSSignature != nil && !useEnvelope {
+		attrName, signature, err := signMessageWithKMSSignatureBlock(ctx, r.AWSClient.KMSClient, data.KMSSignature[0], data.MessageBody.ValueString())
+		if err != nil {
+			return err
+		}
+		userProperties[attrName] = signature
+	}
+
+	userProperties["X-LifeCycle-Event"] = lifeCycle
+
+	// Sorted so the order of the user properties is stable
+	keys := make([]string, 0, len(userProperties))
+	for key := range userProperties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	properties := &paho.PublishProperties{
+		ContentType: data.ContentType.ValueString(),
+	}
+	for _, key := range keys {
+		properties.User.Add(key, userProperties[key])
+	}
+
+	return publishMQTT5Message(ctx, options, &paho.Publish{
+		QoS:        byte(data.QoS.ValueInt32()),
+		Retain:     data.Retain.ValueBool(),
+		Topic:      data.Topic.ValueString(),
+		Properties: properties,
+		Payload:    payload,
+	})
+}
+
+func publishMQTT5Message(ctx context.Context, options MQTTConfigOptions, publish *paho.Publish) error {
+	connection, err := dialMQTTBroker(ctx, options)
+	if err != nil {
+		return err
+	}
+
+	client := paho.NewClient(paho.ClientConfig{
+		Conn: packets.NewThreadSafeConn(connection),
+	})
+
+	connect := &paho.Connect{
+		ClientID:   newMQTTClientID(options),
+		CleanStart: true,
+		KeepAlive:  30,
+	}
+
+	if options.Username != "" {
+		connect.Username = options.Username
+		connect.UsernameFlag = true
+	}
+
+	if options.Password != "" {
+		connect.Password = []byte(options.Password)
+		connect.PasswordFlag = true
+	}
+
+	_, err = client.Connect(ctx, connect)
+	if err != nil {
+		connection.Close()
+		return err
+	}
+	defer client.Disconnect(&paho.Disconnect{ReasonCode: 0})
+
+	// Publish waits for the PUBACK at QoS 1 and the PUBCOMP at QoS 2
+	publishResponse, err := client.Publish(ctx, publish)
+	if err != nil {
+		return err
+	}
+
+	// A failed QoS 2 publish is reported in the PUBREC without an error
+	if publishResponse != nil && publishResponse.ReasonCode >= 0x80 {
+		return fmt.Errorf("broker rejected the message with reason code %d", publishResponse.ReasonCode)
+	}
+
+	return nil
+}
+
+func publishMQTT311Message(ctx context.Context, options MQTTConfigOptions, data *MQTTPublishResourceModel, payload []byte) error {
+	clientOptions := mqtt.NewClientOptions().
+		AddBroker(options.BrokerURL).
+		SetClientID(newMQTTClientID(options)).
+		SetProtocolVersion(4).
+		SetCleanSession(true).
+		SetAutoReconnect(false).
+		SetConnectRetry(false).
+		SetUsername(options.Username).
+		SetPassword(options.Password)
+
+	tlsConfig, err := newMQTTTLSConfig(options)
+	if err != nil {
+		return err
+	}
+	clientOptions.SetTLSConfig(tlsConfig)
+
+	client := mqtt.NewClient(clientOptions)
+
+	err = waitForMQTTToken(ctx, client.Connect())
+	if err != nil {
+		return err
+	}
+	defer client.Disconnect(250)
+
+	// The token completes on the PUBACK at QoS 1 and the PUBCOMP at QoS 2
+	return waitForMQTTToken(ctx, client.Publish(data.Topic.ValueString(), byte(data.QoS.ValueInt32()), data.Retain.ValueBool(), payload))
+}
+
+func waitForMQTTToken(ctx context.Context, token mqtt.Token) error {
+	select {
+	case <-token.Done():
+		return token.Error()
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func dialMQTTBroker(ctx context.Context, options MQTTConfigOptions) (net.Conn, error) {
+	brokerURL, err := url.Parse(options.BrokerURL)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse broker_url: %w", err)
+	}
+
+	dialer := &net.Dialer{Timeout: 30 * time.Second}
+
+	switch brokerURL.Scheme {
+	case "mqtt", "tcp":
+		return dialer.DialContext(ctx, "tcp", mqttBrokerAddress(brokerURL, "1883"))
+	case "mqtts", "ssl", "tls":
+		tlsConfig, err := newMQTTTLSConfig(options)
+		if err != nil {
+			return nil, err
+		}
+
+		tlsDialer := &tls.Dialer{
+			NetDialer: dialer,
+			Config:    tlsConfig,
+		}
+		return tlsDialer.DialContext(ctx, "tcp", mqttBrokerAddress(brokerURL, "8883"))
+	default:
+		return nil, fmt.Errorf("unsupported broker_url scheme %q", brokerURL.Scheme)
+	}
+}
+
+func mqttBrokerAddress(brokerURL *url.URL, defaultPort string) string {
+	if brokerURL.Port() == "" {
+		return net.JoinHostPort(brokerURL.Hostname(), defaultPort)
+	}
+
+	return brokerURL.Host
+}
+
+func newMQTTTLSConfig(options MQTTConfigOptions) (*tls.Config, error) {
+	return newTLSConfig(options.TLSCACert, options.TLSClientCert, options.TLSClientKey, options.TLSInsecureSkipVerify)
+}
+
+func newMQTTClientID(options MQTTConfigOptions) string {
+	prefix := "eventpush-"
+	if options.ClientIDPrefix != "" {
+		prefix = options.ClientIDPrefix
+	}
+
+	// MQTT 3.1.1 brokers are only required to accept client IDs of up to 23 characters, so the
+	// prefix is limited to 15 characters in the provider block
+	return prefix + uuid.New().String()[:8]
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccEventPushMQTTPublish_Simple(t *testing.T) {
+	config1 := `
+provider "eventpush" {
+  mqtt {
+    broker_url = "mqtt://localhost:1883"
+  }
+}
+
+resource "eventpush_mqtt_publish" "test" {
+  topic        = "eventpush/test"
+  qos          = 1
+  message_body = "test message 1"
+}
+`
+
+	config2 := `
+provider "eventpush" {
+  mqtt {
+    broker_url = "mqtt://localhost:1883"
+  }
+}
+
+resource "eventpush_mqtt_publish" "test" {
+  topic        = "eventpush/test"
+  qos          = 1
+  message_body = "test message 2"
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_mqtt_publish.test", "topic", "eventpush/test"),
+					resource.TestCheckResourceAttr("eventpush_mqtt_publish.test", "message_body", "test message 1"),
+					resource.TestCheckResourceAttrSet("eventpush_mqtt_publish.test", "event_id"),
+					resource.TestCheckResourceAttrSet("eventpush_mqtt_publish.test", "md5_of_message_body"),
+				),
+			},
+			{
+				RefreshState: true,
+			},
+			{
+				Config: config2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_mqtt_publish.test", "topic", "eventpush/test"),
+					resource.TestCheckResourceAttr("eventpush_mqtt_publish.test", "message_body", "test message 2"),
+					resource.TestCheckResourceAttrSet("eventpush_mqtt_publish.test", "event_id"),
+					resource.TestCheckResourceAttrSet("eventpush_mqtt_publish.test", "md5_of_message_body"),
+				),
+			},
+			{
+				Config:  config2,
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func TestAccEventPushMQTTPublish_MQTT311WithoutEnvelope(t *testing.T) {
+	config1 := `
+provider "eventpush" {
+  mqtt {
+    broker_url       = "mqtt://localhost:1883"
+    protocol_version = "3.1.1"
+  }
+}
+
+resource "eventpush_mqtt_publish" "test" {
+  topic        = "eventpush/test"
+  envelope     = false
+  message_body = "test message 1"
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config1,
+				ExpectError: regexp.MustCompile("create_only"),
+			},
+		},
+	})
+}
+
+func TestAccEventPushMQTTPublish_ClientIDPrefixTooLong(t *testing.T) {
+	config1 := `
+provider "eventpush" {
+  mqtt {
+    broker_url       = "mqtt://localhost:1883"
+    client_id_prefix = "eventpush-terraform-"
+  }
+}
+
+resource "eventpush_mqtt_publish" "test" {
+  topic        = "eventpush/test"
+  message_body = "test message 1"
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config1,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Length"),
+			},
+		},
+	})
+}
@@ -0,0 +1,357 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"strings"
+)
+
+var _ resource.Resource = &NATSPublishResource{}
+var _ resource.ResourceWithConfigure = &NATSPublishResource{}
+
+type NATSPublishResource struct {
+	AWSClient         *AWSClient
+	NATSConfigOptions NATSConfigOptions
+}
+
+type NATSPublishResourceModel struct {
+	CreateOnly       types.Bool                   `tfsdk:"create_only"`
+	EventId          types.String                 `tfsdk:"event_id"`
+	Headers          types.Map                    `tfsdk:"headers"`
+	KMSSignature     []KMSSignatureAttributeModel `tfsdk:"kms_signature"`
+	MD5OfMessageBody types.String                 `tfsdk:"md5_of_message_body"`
+	MessageBody      types.String                 `tfsdk:"message_body"`
+	SendCount        types.Int64                  `tfsdk:"send_count"`
+	Sequence         types.Int64                  `tfsdk:"sequence"`
+	Stream           types.String                 `tfsdk:"stream"`
+	Subject          types.String                 `tfsdk:"subject"`
+}
+
+func newNATSPublishResource() resource.Resource {
+	return &NATSPublishResource{}
+}
+
+func (r *NATSPublishResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerMeta := request.ProviderData.(Meta)
+
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("unable to load SDK config", err.Error())
+		return
+	}
+
+	if providerMeta.AWSConfigOptions.Region != "" {
+		cfg.Region = providerMeta.AWSConfigOptions.Region
+	}
+
+	kmsClient := kms.NewFromConfig(cfg)
+
+	r.AWSClient = &AWSClient{
+		KMSClient: kmsClient,
+		Region:    providerMeta.AWSConfigOptions.Region,
+	}
+	r.NATSConfigOptions = providerMeta.NATSConfigOptions
+}
+
+func (r *NATSPublishResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_nats_publish"
+}
+
+func (r *NATSPublishResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Publish a message to a NATS subject. When the subject is bound to a JetStream stream, the message is deduplicated by the server and the publish waits for the stream acknowledgement.",
+		Attributes: map[string]schema.Attribute{
+			"create_only": schema.BoolAttribute{
+				Description: "When enabled, forces resource to be replaced on update.",
+				Optional:    true,
+			},
+			"event_id": schema.StringAttribute{
+				Description: "Generated ID for resource tracking.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"headers": schema.MapAttribute{
+				Description: "Headers to send with the message.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"md5_of_message_body": schema.StringAttribute{
+				Description: "The MD5 of the message body.",
+				Computed:    true,
+			},
+			"message_body": schema.StringAttribute{
+				Description: "The message to send.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(replaceIfCreateOnlySet, "Forces replacement of resource.", "Forces replacement of resource."),
+				},
+			},
+			"send_count": schema.Int64Attribute{
+				Description: "The number of messages published. Used in the JetStream message ID so each publish is stored once.",
+				Computed:    true,
+			},
+			"sequence": schema.Int64Attribute{
+				Description: "The stream sequence of the last message published, when the subject is bound to a JetStream stream.",
+				Computed:    true,
+			},
+			"stream": schema.StringAttribute{
+				Description: "The name of the JetStream stream the last message was stored in.",
+				Computed:    true,
+			},
+			"subject": schema.StringAttribute{
+				Description: "The subject to publish to.",
+				Required:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"kms_signature": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"kms_key_id": schema.StringAttribute{
+							Description: "The ID of the AWS KMS key.",
+							Required:    true,
+						},
+						"message_attribute": schema.StringAttribute{
+							Description: "Header name to add signature value.",
+							Optional:    true,
+						},
+						"algorithm": schema.StringAttribute{
+							Description: "The KMS signature algorithm.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOfCaseInsensitive(
+									[]string{
+										string(kmstypes.SigningAlgorithmSpecRsassaPkcs1V15Sha256),
+									}...,
+								),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *NATSPublishResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data NATSPublishResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Event ID is set before publishing since it is used for deduplication
+	data.EventId = types.StringValue(uuid.New().String())
+	data.SendCount = types.Int64Value(0)
+
+	err := publishNATSMessage(ctx, r, &data, "create")
+	if err != nil {
+		response.Diagnostics.AddError("Error publishing message to NATS subject.", err.Error())
+		return
+	}
+
+	data.MD5OfMessageBody = types.StringValue(createMD5OfMessageBody(data.MessageBody.ValueString()))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *NATSPublishResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data NATSPublishResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *NATSPublishResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state NATSPublishResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	planMessageBodyMD5 := createMD5OfMessageBody(plan.MessageBody.ValueString())
+	stateMessageBodyMD5 := createMD5OfMessageBody(state.MessageBody.ValueString())
+
+	plan.SendCount = state.SendCount
+	if planMessageBodyMD5 != stateMessageBodyMD5 {
+		err := publishNATSMessage(ctx, r, &plan, "update")
+		if err != nil {
+			response.Diagnostics.AddError("Error publishing message to NATS subject.", err.Error())
+			return
+		}
+	} else {
+		plan.Sequence = state.Sequence
+		plan.Stream = state.Stream
+	}
+	plan.MD5OfMessageBody = types.StringValue(planMessageBodyMD5)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *NATSPublishResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data NATSPublishResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.CreateOnly.ValueBool() {
+		err := publishNATSMessage(ctx, r, &data, "delete")
+		if err != nil {
+			response.Diagnostics.AddError("Error publishing message to NATS subject.", err.Error())
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func publishNATSMessage(ctx context.Context, r *NATSPublishResource, data *NATSPublishResourceModel, lifeCycle string) error {
+	message := nats.NewMsg(data.Subject.ValueString())
+	message.Data = []byte(data.MessageBody.ValueString())
+
+	if !data.Headers.IsNull() {
+		headers := make(map[string]string)
+		diags := data.Headers.ElementsAs(ctx, &headers, false)
+		if diags.HasError() {
+			return fmt.Errorf("unable to read headers")
+		}
+
+		for key, value := range headers {
+			message.Header.Set(key, value)
+		}
+	}
+
+	if data.KMSSignature != nil {
+		attrName, signature, err := signMessageWithKMSSignatureBlock(ctx, r.AWSClient.KMSClient, data.KMSSignature[0], data.MessageBody.ValueString())
+		if err != nil {
+			return err
+		}
+		message.Header.Set(attrName, signature)
+	}
+
+	message.Header.Set("X-LifeCycle-Event", lifeCycle)
+
+	sendCount := data.SendCount.ValueInt64() + 1
+
+	connection, err := newNATSConnection(r.NATSConfigOptions)
+	if err != nil {
+		return err
+	}
+	defer connection.Close()
+
+	js, err := jetstream.New(connection)
+	if err != nil {
+		return err
+	}
+
+	// Subjects without a stream, or servers without JetStream, get a core NATS publish
+	_, err = js.StreamNameBySubject(ctx, data.Subject.ValueString())
+	if err != nil {
+		if !errors.Is(err, jetstream.ErrStreamNotFound) && !errors.Is(err, jetstream.ErrJetStreamNotEnabled) && !errors.Is(err, nats.ErrNoResponders) {
+			return err
+		}
+
+		err = connection.PublishMsg(message)
+		if err != nil {
+			return err
+		}
+
+		err = connection.FlushWithContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		data.SendCount = types.Int64Value(sendCount)
+		data.Sequence = types.Int64Null()
+		data.Stream = types.StringNull()
+
+		return nil
+	}
+
+	// The message ID lets the stream drop duplicates from a retried apply
+	messageId := lifecycleSendId(data.EventId.ValueString(), lifeCycle, sendCount, data.MessageBody.ValueString())
+
+	ack, err := js.PublishMsg(ctx, message, jetstream.WithMsgID(messageId))
+	if err != nil {
+		return err
+	}
+
+	data.SendCount = types.Int64Value(sendCount)
+	data.Sequence = types.Int64Value(int64(ack.Sequence))
+	data.Stream = types.StringValue(ack.Stream)
+
+	return nil
+}
+
+func newNATSConnection(options NATSConfigOptions) (*nats.Conn, error) {
+	if len(options.Servers) == 0 {
+		return nil, fmt.Errorf("servers must be set in the nats provider block")
+	}
+
+	opts := []nats.Option{
+		nats.Name("eventpush"),
+	}
+
+	if options.CredentialsFile != "" {
+		opts = append(opts, nats.UserCredentials(options.CredentialsFile))
+	}
+
+	if options.NKeySeedFile != "" {
+		nkeyOption, err := nats.NkeyOptionFromSeed(options.NKeySeedFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load NKey seed: %w", err)
+		}
+		opts = append(opts, nkeyOption)
+	}
+
+	if options.Token != "" {
+		opts = append(opts, nats.Token(options.Token))
+	}
+
+	if options.TLSCACert != "" || options.TLSClientCert != "" || options.TLSInsecureSkipVerify {
+		tlsConfig, err := newTLSConfig(options.TLSCACert, options.TLSClientCert, options.TLSClientKey, options.TLSInsecureSkipVerify)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, nats.Secure(tlsConfig))
+	}
+
+	return nats.Connect(strings.Join(options.Servers, ","), opts...)
+}
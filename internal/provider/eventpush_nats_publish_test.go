@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccEventPushNATSPublish_Simple(t *testing.T) {
+	config1 := `
+provider "eventpush" {
+  nats {
+    servers = ["nats://localhost:4222"]
+  }
+}
+
+resource "eventpush_nats_publish" "test" {
+  subject      = "eventpush.test"
+  message_body = "test message 1"
+}
+`
+
+	config2 := `
+provider "eventpush" {
+  nats {
+    servers = ["nats://localhost:4222"]
+  }
+}
+
+resource "eventpush_nats_publish" "test" {
+  subject      = "eventpush.test"
+  message_body = "test message 2"
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_nats_publish.test", "subject", "eventpush.test"),
+					resource.TestCheckResourceAttr("eventpush_nats_publish.test", "message_body", "test message 1"),
+					resource.TestCheckResourceAttrSet("eventpush_nats_publish.test", "event_id"),
+					resource.TestCheckResourceAttr("eventpush_nats_publish.test", "send_count", "1"),
+				),
+			},
+			{
+				RefreshState: true,
+			},
+			{
+				Config: config2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_nats_publish.test", "subject", "eventpush.test"),
+					resource.TestCheckResourceAttr("eventpush_nats_publish.test", "message_body", "test message 2"),
+					resource.TestCheckResourceAttr("eventpush_nats_publish.test", "send_count", "2"),
+				),
+			},
+			{
+				Config:  config2,
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func TestAccEventPushNATSPublish_JetStream(t *testing.T) {
+	// Requires a JetStream stream bound to the eventpush.stream.> subjects
+	config1 := `
+provider "eventpush" {
+  nats {
+    servers = ["nats://localhost:4222"]
+  }
+}
+
+resource "eventpush_nats_publish" "test" {
+  subject      = "eventpush.stream.test"
+  message_body = "test message 1"
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("eventpush_nats_publish.test", "sequence"),
+					resource.TestCheckResourceAttrSet("eventpush_nats_publish.test", "stream"),
+				),
+			},
+			{
+				Config:  config1,
+				Destroy: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,369 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	redisModePubSub = "pubsub"
+	redisModeStream = "stream"
+)
+
+var _ resource.Resource = &RedisStreamAddResource{}
+var _ resource.ResourceWithConfigure = &RedisStreamAddResource{}
+var _ resource.ResourceWithValidateConfig = &RedisStreamAddResource{}
+
+type RedisStreamAddResource struct {
+	AWSClient          *AWSClient
+	RedisConfigOptions RedisConfigOptions
+}
+
+type RedisStreamAddResourceModel struct {
+	ApproximateTrim  types.Bool                   `tfsdk:"approximate_trim"`
+	CreateOnly       types.Bool                   `tfsdk:"create_only"`
+	EntryId          types.String                 `tfsdk:"entry_id"`
+	EventId          types.String                 `tfsdk:"event_id"`
+	Key              types.String                 `tfsdk:"key"`
+	KMSSignature     []KMSSignatureAttributeModel `tfsdk:"kms_signature"`
+	MaxLen           types.Int64                  `tfsdk:"max_len"`
+	MD5OfMessageBody types.String                 `tfsdk:"md5_of_message_body"`
+	MessageBody      types.String                 `tfsdk:"message_body"`
+	Mode             types.String                 `tfsdk:"mode"`
+	SubscriberCount  types.Int64                  `tfsdk:"subscriber_count"`
+}
+
+func newRedisStreamAddResource() resource.Resource {
+	return &RedisStreamAddResource{}
+}
+
+func (r *RedisStreamAddResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerMeta := request.ProviderData.(Meta)
+
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("unable to load SDK config", err.Error())
+		return
+	}
+
+	if providerMeta.AWSConfigOptions.Region != "" {
+		cfg.Region = providerMeta.AWSConfigOptions.Region
+	}
+
+	kmsClient := kms.NewFromConfig(cfg)
+
+	r.AWSClient = &AWSClient{
+		KMSClient: kmsClient,
+		Region:    providerMeta.AWSConfigOptions.Region,
+	}
+	r.RedisConfigOptions = providerMeta.RedisConfigOptions
+}
+
+func (r *RedisStreamAddResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_redis_stream_add"
+}
+
+func (r *RedisStreamAddResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Add an entry to a Redis stream, or publish a message to a Redis Pub/Sub channel. The message is sent as the fields `message_body`, `X-LifeCycle-Event` and `event_id`, plus the signature field named by `kms_signature.message_attribute` (default `X-KMS-Signature`) when signed.",
+		Attributes: map[string]schema.Attribute{
+			"approximate_trim": schema.BoolAttribute{
+				Description: "When enabled, the stream is trimmed with `~` so Redis may keep slightly more than `max_len` entries, which is more efficient.",
+				Optional:    true,
+			},
+			"create_only": schema.BoolAttribute{
+				Description: "When enabled, forces resource to be replaced on update.",
+				Optional:    true,
+			},
+			"entry_id": schema.StringAttribute{
+				Description: "The ID of the last stream entry added. Only set in `stream` mode.",
+				Computed:    true,
+			},
+			"event_id": schema.StringAttribute{
+				Description: "Generated ID for resource tracking.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Description: "The stream key, or the channel name in `pubsub` mode.",
+				Required:    true,
+			},
+			"max_len": schema.Int64Attribute{
+				Description: "When set, the stream is trimmed to this many entries as entries are added. Only used in `stream` mode.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"md5_of_message_body": schema.StringAttribute{
+				Description: "The MD5 of the message body.",
+				Computed:    true,
+			},
+			"message_body": schema.StringAttribute{
+				Description: "The message to send.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(replaceIfCreateOnlySet, "Forces replacement of resource.", "Forces replacement of resource."),
+				},
+			},
+			"mode": schema.StringAttribute{
+				Description: "Either `stream` to XADD the message fields to a stream or `pubsub` to PUBLISH them as a JSON object to a channel. Defaults to `stream`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						[]string{
+							redisModeStream,
+							redisModePubSub,
+						}...,
+					),
+				},
+			},
+			"subscriber_count": schema.Int64Attribute{
+				Description: "The number of subscribers that received the last message. Only set in `pubsub` mode.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"kms_signature": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"kms_key_id": schema.StringAttribute{
+							Description: "The ID of the AWS KMS key.",
+							Required:    true,
+						},
+						"message_attribute": schema.StringAttribute{
+							Description: "Field name to add signature value.",
+							Optional:    true,
+						},
+						"algorithm": schema.StringAttribute{
+							Description: "The KMS signature algorithm.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOfCaseInsensitive(
+									[]string{
+										string(kmstypes.SigningAlgorithmSpecRsassaPkcs1V15Sha256),
+									}...,
+								),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *RedisStreamAddResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data RedisStreamAddResourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Mode.ValueString() != redisModePubSub {
+		return
+	}
+
+	if !data.MaxLen.IsNull() {
+		response.Diagnostics.AddAttributeError(
+			path.Root("max_len"),
+			"Invalid max_len",
+			"Pub/Sub channels do not store messages, so max_len can only be set in stream mode.",
+		)
+	}
+
+	if !data.ApproximateTrim.IsNull() {
+		response.Diagnostics.AddAttributeError(
+			path.Root("approximate_trim"),
+			"Invalid approximate_trim",
+			"Pub/Sub channels do not store messages, so approximate_trim can only be set in stream mode.",
+		)
+	}
+}
+
+func (r *RedisStreamAddResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data RedisStreamAddResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Event ID is set before sending since it is one of the message fields
+	data.EventId = types.StringValue(uuid.New().String())
+
+	err := addRedisMessage(ctx, r, &data, "create")
+	if err != nil {
+		response.Diagnostics.AddError("Error sending message to Redis.", err.Error())
+		return
+	}
+
+	data.MD5OfMessageBody = types.StringValue(createMD5OfMessageBody(data.MessageBody.ValueString()))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *RedisStreamAddResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data RedisStreamAddResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *RedisStreamAddResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state RedisStreamAddResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	planMessageBodyMD5 := createMD5OfMessageBody(plan.MessageBody.ValueString())
+	stateMessageBodyMD5 := createMD5OfMessageBody(state.MessageBody.ValueString())
+
+	if planMessageBodyMD5 != stateMessageBodyMD5 {
+		err := addRedisMessage(ctx, r, &plan, "update")
+		if err != nil {
+			response.Diagnostics.AddError("Error sending message to Redis.", err.Error())
+			return
+		}
+	} else {
+		plan.EntryId = state.EntryId
+		plan.SubscriberCount = state.SubscriberCount
+	}
+	plan.MD5OfMessageBody = types.StringValue(planMessageBodyMD5)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *RedisStreamAddResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data RedisStreamAddResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.CreateOnly.ValueBool() {
+		err := addRedisMessage(ctx, r, &data, "delete")
+		if err != nil {
+			response.Diagnostics.AddError("Error sending message to Redis.", err.Error())
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func addRedisMessage(ctx context.Context, r *RedisStreamAddResource, data *RedisStreamAddResourceModel, lifeCycle string) error {
+	fields, err := createMessageEnvelope(ctx, r.AWSClient.KMSClient, data.KMSSignature, data.MessageBody.ValueString(), lifeCycle)
+	if err != nil {
+		return err
+	}
+	fields["event_id"] = data.EventId.ValueString()
+
+	client, err := newRedisClient(r.RedisConfigOptions)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if data.Mode.ValueString() == redisModePubSub {
+		message, err := json.Marshal(fields)
+		if err != nil {
+			return err
+		}
+
+		subscriberCount, err := client.Publish(ctx, data.Key.ValueString(), message).Result()
+		if err != nil {
+			return err
+		}
+
+		data.EntryId = types.StringNull()
+		data.SubscriberCount = types.Int64Value(subscriberCount)
+
+		return nil
+	}
+
+	args := &redis.XAddArgs{
+		Stream: data.Key.ValueString(),
+		Values: fields,
+	}
+
+	if !data.MaxLen.IsNull() {
+		args.MaxLen = data.MaxLen.ValueInt64()
+		args.Approx = data.ApproximateTrim.ValueBool()
+	}
+
+	entryId, err := client.XAdd(ctx, args).Result()
+	if err != nil {
+		return err
+	}
+
+	data.EntryId = types.StringValue(entryId)
+	data.SubscriberCount = types.Int64Null()
+
+	return nil
+}
+
+func newRedisClient(options RedisConfigOptions) (*redis.Client, error) {
+	if options.Address == "" {
+		return nil, fmt.Errorf("address must be set in the redis provider block")
+	}
+
+	redisOptions := &redis.Options{
+		Addr:     options.Address,
+		DB:       options.DB,
+		Password: options.Password,
+		Username: options.Username,
+	}
+
+	tlsEnabled := options.TLSEnabled ||
+		options.TLSCACert != "" || options.TLSClientCert != "" || options.TLSInsecureSkipVerify
+
+	if tlsEnabled {
+		tlsConfig, err := newTLSConfig(options.TLSCACert, options.TLSClientCert, options.TLSClientKey, options.TLSInsecureSkipVerify)
+		if err != nil {
+			return nil, err
+		}
+		redisOptions.TLSConfig = tlsConfig
+	}
+
+	return redis.NewClient(redisOptions), nil
+}
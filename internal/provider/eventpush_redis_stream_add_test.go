@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccEventPushRedisStreamAdd_Simple(t *testing.T) {
+	config1 := `
+provider "eventpush" {
+  redis {
+    address = "localhost:6379"
+  }
+}
+
+resource "eventpush_redis_stream_add" "test" {
+  key          = "eventpush.test"
+  max_len      = 1000
+  message_body = "test message 1"
+}
+`
+
+	config2 := `
+provider "eventpush" {
+  redis {
+    address = "localhost:6379"
+  }
+}
+
+resource "eventpush_redis_stream_add" "test" {
+  key          = "eventpush.test"
+  max_len      = 1000
+  message_body = "test message 2"
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() {},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_redis_stream_add.test", "key", "eventpush.test"),
+					resource.TestCheckResourceAttr("eventpush_redis_stream_add.test", "message_body", "test message 1"),
+					resource.TestCheckResourceAttrSet("eventpush_redis_stream_add.test", "entry_id"),
+				),
+			},
+			{
+				RefreshState: true,
+			},
+			{
+				Config: config2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("eventpush_redis_stream_add.test", "key", "eventpush.test"),
+					resource.TestCheckResourceAttr("eventpush_redis_stream_add.test", "message_body", "test message 2"),
+					resource.TestCheckResourceAttrSet("eventpush_redis_stream_add.test", "entry_id"),
+				),
+			},
+			{
+				Config:  config2,
+				Destroy: true,
+			},
+		},
+	})
+}